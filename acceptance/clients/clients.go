@@ -5,7 +5,6 @@ package clients
 
 import (
 	"fmt"
-	"net/http"
 	"os"
 	"strings"
 
@@ -655,11 +654,9 @@ func NewKeyManagerV1Client() (*gophercloud.ServiceClient, error) {
 // requests and responses if OS_DEBUG is enabled.
 func configureDebug(client *gophercloud.ProviderClient) *gophercloud.ProviderClient {
 	if os.Getenv("OS_DEBUG") != "" {
-		client.HTTPClient = http.Client{
-			Transport: &LogRoundTripper{
-				Rt: &http.Transport{},
-			},
-		}
+		client.Use(gophercloud.NewLoggingMiddleware(DebugLogger{}, gophercloud.LoggingOpts{
+			LogBodies: true,
+		}))
 	}
 
 	return client
@@ -10,9 +10,16 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
 )
 
 // List of headers that need to be redacted
+//
+// Deprecated: gophercloud.DefaultRedactedHeaders are always redacted, use
+// gophercloud.LoggingOpts.RedactHeaders to redact more headers. Headers
+// added to REDACT_HEADERS are still redacted by LogRoundTripper.
 var REDACT_HEADERS = []string{"x-auth-token", "x-auth-key", "x-service-token",
 	"x-storage-token", "x-account-meta-temp-url-key", "x-account-meta-temp-url-key-2",
 	"x-container-meta-temp-url-key", "x-container-meta-temp-url-key-2", "set-cookie",
@@ -20,166 +27,108 @@ var REDACT_HEADERS = []string{"x-auth-token", "x-auth-key", "x-service-token",
 
 // LogRoundTripper satisfies the http.RoundTripper interface and is used to
 // customize the default http client RoundTripper to allow logging.
+//
+// Deprecated: use gophercloud.NewLoggingMiddleware with DebugLogger, which
+// logs logical requests rather than HTTP round-trips.
 type LogRoundTripper struct {
 	Rt http.RoundTripper
 }
 
 // RoundTrip performs a round-trip HTTP request and logs relevant information
-// about it.
+// about it through DebugLogger.
 func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
-	defer func() {
-		if request.Body != nil {
-			request.Body.Close()
-		}
-	}()
-
-	var err error
-
-	log.Printf("[DEBUG] OpenStack Request URL: %s %s", request.Method, request.URL)
-	log.Printf("[DEBUG] OpenStack request Headers:\n%s", formatHeaders(request.Header))
+	record := gophercloud.LogRecord{
+		Method:         request.Method,
+		URL:            request.URL.String(),
+		RequestHeaders: gophercloud.RedactHeaders(request.Header, REDACT_HEADERS...),
+	}
 
 	if request.Body != nil {
-		request.Body, err = lrt.logRequest(request.Body, request.Header.Get("Content-Type"))
+		body, err := readBody(request.Body)
 		if err != nil {
 			return nil, err
 		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if strings.HasPrefix(request.Header.Get("Content-Type"), "application/json") {
+			record.RequestBody = gophercloud.RedactJSONBody(body, "")
+		}
 	}
 
+	start := time.Now()
 	response, err := lrt.Rt.RoundTrip(request)
+	record.Duration = time.Since(start)
+	record.Err = err
 	if response == nil {
+		DebugLogger{}.Log(record)
 		return nil, err
 	}
 
-	log.Printf("[DEBUG] OpenStack Response Code: %d", response.StatusCode)
-	log.Printf("[DEBUG] OpenStack Response Headers:\n%s", formatHeaders(response.Header))
-
-	response.Body, err = lrt.logResponse(response.Body, response.Header.Get("Content-Type"))
-
-	return response, err
-}
-
-// logRequest will log the HTTP Request details.
-// If the body is JSON, it will attempt to be pretty-formatted.
-func (lrt *LogRoundTripper) logRequest(original io.ReadCloser, contentType string) (io.ReadCloser, error) {
-	defer original.Close()
-
-	var bs bytes.Buffer
-	_, err := io.Copy(&bs, original)
-	if err != nil {
-		return nil, err
-	}
-
-	// Handle request contentType
-	if strings.HasPrefix(contentType, "application/json") {
-		debugInfo := lrt.formatJSON(bs.Bytes())
-		log.Printf("[DEBUG] OpenStack Request Body: %s", debugInfo)
-	}
+	record.StatusCode = response.StatusCode
+	record.RequestID = response.Header.Get("X-Openstack-Request-Id")
+	record.ResponseHeaders = gophercloud.RedactHeaders(response.Header, REDACT_HEADERS...)
 
-	return ioutil.NopCloser(strings.NewReader(bs.String())), nil
-}
-
-// logResponse will log the HTTP Response details.
-// If the body is JSON, it will attempt to be pretty-formatted.
-func (lrt *LogRoundTripper) logResponse(original io.ReadCloser, contentType string) (io.ReadCloser, error) {
-	if strings.HasPrefix(contentType, "application/json") {
-		var bs bytes.Buffer
-		defer original.Close()
-		_, err := io.Copy(&bs, original)
+	if strings.HasPrefix(response.Header.Get("Content-Type"), "application/json") {
+		body, err := readBody(response.Body)
 		if err != nil {
 			return nil, err
 		}
-		debugInfo := lrt.formatJSON(bs.Bytes())
-		if debugInfo != "" {
-			log.Printf("[DEBUG] OpenStack Response Body: %s", debugInfo)
-		}
-		return ioutil.NopCloser(strings.NewReader(bs.String())), nil
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+		record.ResponseBody = gophercloud.RedactJSONBody(body, "")
 	}
 
-	log.Printf("[DEBUG] Not logging because OpenStack response body isn't JSON")
-	return original, nil
+	DebugLogger{}.Log(record)
+	return response, err
 }
 
-// formatJSON will try to pretty-format a JSON body.
-// It will also mask known fields which contain sensitive information.
-func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
-	var rawData interface{}
-
-	err := json.Unmarshal(raw, &rawData)
-	if err != nil {
-		log.Printf("[DEBUG] Unable to parse OpenStack JSON: %s", err)
-		return string(raw)
-	}
-
-	data, ok := rawData.(map[string]interface{})
-	if !ok {
-		pretty, err := json.MarshalIndent(rawData, "", "  ")
-		if err != nil {
-			log.Printf("[DEBUG] Unable to re-marshal OpenStack JSON: %s", err)
-			return string(raw)
-		}
-
-		return string(pretty)
-	}
+func readBody(original io.ReadCloser) ([]byte, error) {
+	defer original.Close()
+	return ioutil.ReadAll(original)
+}
 
-	// Mask known password fields
-	if v, ok := data["auth"].(map[string]interface{}); ok {
-		if v, ok := v["identity"].(map[string]interface{}); ok {
-			if v, ok := v["password"].(map[string]interface{}); ok {
-				if v, ok := v["user"].(map[string]interface{}); ok {
-					v["password"] = "***"
-				}
-			}
-			if v, ok := v["application_credential"].(map[string]interface{}); ok {
-				v["secret"] = "***"
-			}
-			if v, ok := v["token"].(map[string]interface{}); ok {
-				v["id"] = "***"
-			}
-		}
+// DebugLogger satisfies the gophercloud.Logger interface and prints the
+// records emitted by the gophercloud logging middleware through the log
+// package.
+type DebugLogger struct{}
+
+// Log prints relevant information about a request and its response.
+func (DebugLogger) Log(record gophercloud.LogRecord) {
+	log.Printf("[DEBUG] OpenStack Request URL: %s %s", record.Method, record.URL)
+	log.Printf("[DEBUG] OpenStack request Headers:\n%s", formatHeaders(record.RequestHeaders))
+	if record.RequestBody != "" {
+		log.Printf("[DEBUG] OpenStack Request Body: %s", formatJSON(record.RequestBody))
 	}
 
-	// Ignore the catalog
-	if v, ok := data["token"].(map[string]interface{}); ok {
-		if _, ok := v["catalog"]; ok {
-			return ""
-		}
+	if record.StatusCode == 0 {
+		log.Printf("[DEBUG] OpenStack Request failed after %s: %s", record.Duration, record.Err)
+		return
 	}
 
-	pretty, err := json.MarshalIndent(data, "", "  ")
-	if err != nil {
-		log.Printf("[DEBUG] Unable to re-marshal OpenStack JSON: %s", err)
-		return string(raw)
+	log.Printf("[DEBUG] OpenStack Response Code: %d (request ID %q, %s)", record.StatusCode, record.RequestID, record.Duration)
+	log.Printf("[DEBUG] OpenStack Response Headers:\n%s", formatHeaders(record.ResponseHeaders))
+	if record.ResponseBody != "" {
+		log.Printf("[DEBUG] OpenStack Response Body: %s", formatJSON(record.ResponseBody))
 	}
-
-	return string(pretty)
 }
 
-// redactHeaders processes a headers object, returning a redacted list
-func redactHeaders(headers http.Header) (processedHeaders []string) {
-	for name, header := range headers {
-		var sensitive bool
-
-		for _, redact_header := range REDACT_HEADERS {
-			if strings.ToLower(name) == strings.ToLower(redact_header) {
-				sensitive = true
-			}
-		}
-
-		for _, v := range header {
-			if sensitive {
-				processedHeaders = append(processedHeaders, fmt.Sprintf("%v: %v", name, "***"))
-			} else {
-				processedHeaders = append(processedHeaders, fmt.Sprintf("%v: %v", name, v))
-			}
-		}
+// formatJSON will try to pretty-format a JSON body.
+func formatJSON(raw string) string {
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, []byte(raw), "", "  "); err != nil {
+		return raw
 	}
-	return
+	return pretty.String()
 }
 
-// formatHeaders processes a headers object plus a deliminator, returning a string
+// formatHeaders processes an already redacted headers object, returning a
+// string
 func formatHeaders(headers http.Header) string {
-	redactedHeaders := redactHeaders(headers)
-	sort.Strings(redactedHeaders)
+	var lines []string
+	for name, values := range headers {
+		for _, v := range values {
+			lines = append(lines, fmt.Sprintf("%v: %v", name, v))
+		}
+	}
+	sort.Strings(lines)
 
-	return strings.Join(redactedHeaders, "\n")
+	return strings.Join(lines, "\n")
 }
@@ -45,9 +45,17 @@ type AuthOptions struct {
 
 	Password string `json:"password,omitempty"`
 
-	// Passcode is used in TOTP authentication method
+	// Passcode is used in TOTP authentication method. It can be combined with
+	// Password or with application credentials, in which case the user owning
+	// the application credential must be given with UserID or Username.
 	Passcode string `json:"passcode,omitempty"`
 
+	// Receipt is the auth receipt returned by Keystone, in an
+	// ErrAuthReceiptRequired error of the v3 tokens package, when the user
+	// must authenticate with additional methods. Only the missing methods need
+	// to be provided along with it.
+	Receipt string `json:"-"`
+
 	// At most one of DomainID and DomainName must be provided if using Username
 	// with Identity V3. Otherwise, either are optional.
 	DomainID   string `json:"-"`
@@ -198,7 +206,9 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	// if insufficient or incompatible information is present.
 	var req request
 
-	if opts.Password == "" && opts.Passcode == "" {
+	isAppCred := opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != ""
+
+	if opts.Password == "" && (opts.Passcode == "" || isAppCred) {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
 			// parameters.
@@ -284,6 +294,28 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 			// If no password or token ID or ApplicationCredential are available, authentication can't continue.
 			return nil, ErrMissingPassword{}
 		}
+
+		// TOTP authentication along with the application credential. The
+		// TOTP method always identifies the user explicitly.
+		if opts.Passcode != "" {
+			var userRequest *userReq
+			switch {
+			case opts.UserID != "":
+				userRequest = &userReq{ID: &opts.UserID}
+			case opts.Username == "":
+				return nil, ErrUsernameOrUserID{}
+			case opts.DomainID != "":
+				userRequest = &userReq{Name: &opts.Username, Domain: &domainReq{ID: &opts.DomainID}}
+			case opts.DomainName != "":
+				userRequest = &userReq{Name: &opts.Username, Domain: &domainReq{Name: &opts.DomainName}}
+			default:
+				return nil, ErrDomainIDOrDomainName{}
+			}
+			userRequest.Passcode = &opts.Passcode
+
+			req.Auth.Identity.Methods = append(req.Auth.Identity.Methods, "totp")
+			req.Auth.Identity.TOTP = &totpReq{User: userRequest}
+		}
 	} else {
 		// Password authentication.
 		if opts.Password != "" {
@@ -499,8 +531,8 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 }
 
 func (opts AuthOptions) CanReauth() bool {
-	if opts.Passcode != "" {
-		// cannot reauth using TOTP passcode
+	if opts.Passcode != "" || opts.Receipt != "" {
+		// cannot reauth using TOTP passcode or a short-lived auth receipt
 		return false
 	}
 
@@ -508,7 +540,10 @@ func (opts AuthOptions) CanReauth() bool {
 }
 
 // ToTokenV3HeadersMap allows AuthOptions to satisfy the AuthOptionsBuilder
-// interface in the v3 tokens package.
+// interface in the v3 tokens package. It sends the auth receipt, if any.
 func (opts *AuthOptions) ToTokenV3HeadersMap(map[string]interface{}) (map[string]string, error) {
-	return nil, nil
+	if opts.Receipt == "" {
+		return nil, nil
+	}
+	return map[string]string{"Openstack-Auth-Receipt": opts.Receipt}, nil
 }
@@ -0,0 +1,78 @@
+package gophercloud
+
+/*
+AuthProvider is a source of credentials for a ProviderClient. It obtains the
+token the client authenticates its requests with, along with the way to
+locate the endpoints of the services, refreshes the token and revokes it.
+
+openstack.AuthenticateWithProvider authenticates a ProviderClient with an
+AuthProvider: it records the token and the EndpointLocator on the client,
+sets up its ReauthFunc and the background token refresh, and stores the
+token in the client's TokenCache if the AuthProvider implements
+TokenCacheKeyer. The following functions return AuthProviders:
+
+	github.com/yogeshwargnanasekaran/gophercloud/openstack.NewAuthProvider
+	github.com/yogeshwargnanasekaran/gophercloud/openstack.NewAuthProviderV2
+	github.com/yogeshwargnanasekaran/gophercloud/openstack.NewAuthProviderV3
+	github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetal/noauth.NewAuthProvider
+	github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetal/httpbasic.NewAuthProvider
+
+Custom sources of tokens, such as a secret store or a token broker, only
+need to implement this interface to be used in their place. The AuthResult
+an AuthProvider returns determines how requests are authenticated: its token
+ID, if not empty, is sent in the X-Auth-Token header, and it may send other
+headers by implementing AuthHeadersResult. If it implements
+ExtractExpiresAt() (time.Time, error), the token is refreshed before it
+expires according to ProviderClient.TokenRefreshBefore.
+
+AuthProviders must be safe for concurrent use.
+*/
+type AuthProvider interface {
+	// Authenticate obtains a new token and the EndpointLocator of the
+	// services it gives access to. client may be used to issue requests, but
+	// must not be modified.
+	Authenticate(client *ProviderClient) (AuthResult, EndpointLocator, error)
+
+	// CanReauth reports whether Refresh may be called to obtain a new token
+	// once the current one has expired or has been rejected.
+	CanReauth() bool
+
+	// Refresh obtains a new token. client is a throw-away client, which does
+	// not authenticate the requests it issues.
+	Refresh(client *ProviderClient) (AuthResult, error)
+
+	// Revoke invalidates the token of result, if the source of the token
+	// supports it.
+	Revoke(client *ProviderClient, result AuthResult) error
+}
+
+// AuthHeadersResult is implemented by the AuthResults whose credentials are
+// sent in headers other than X-Auth-Token, such as HTTP basic
+// authentication. ProviderClient.AuthenticatedHeaders includes these headers.
+type AuthHeadersResult interface {
+	AuthResult
+
+	// AuthHeaders returns the headers sent with every authenticated request.
+	AuthHeaders() map[string]string
+}
+
+// TokenCacheKeyer is implemented by the AuthProviders whose tokens may be
+// stored in a TokenCache.
+type TokenCacheKeyer interface {
+	// TokenCacheKey returns the key the tokens of the AuthProvider are
+	// stored under, or an empty string if they must not be cached.
+	TokenCacheKey() string
+}
+
+// TokenCacheVerifier is implemented by the TokenCacheKeyers whose key does
+// not cover the secrets their tokens are obtained with. A cached token is
+// only reused if VerifyTokenCacheSecret accepts its SecretHash.
+type TokenCacheVerifier interface {
+	// TokenCacheSecretHash returns the SecretHash stored with the tokens of
+	// the AuthProvider.
+	TokenCacheSecretHash() (string, error)
+
+	// VerifyTokenCacheSecret reports whether hash was computed from the
+	// secrets of the AuthProvider.
+	VerifyTokenCacheSecret(hash string) bool
+}
@@ -9,9 +9,8 @@ Provider structs represent the cloud providers that offer and manage a
 collection of services. You will generally want to create one Provider
 client per OpenStack cloud.
 
-	It is now recommended to use the `clientconfig` package found at
-	https://github.com/gophercloud/utils/tree/master/openstack/clientconfig
-	for all authentication purposes.
+	It is now recommended to use the `openstack/clientconfig` package,
+	which reads clouds.yaml, for all authentication purposes.
 
 	The below documentation is still relevant. clientconfig simply implements
 	the below and presents it in an easier and more flexible way.
@@ -60,6 +59,21 @@ pass in the parent provider, like so:
 
 	client, err := openstack.NewComputeV2(provider, opts)
 
+Services are found in the service catalog under their official service type
+or any of its aliases, so NewBlockStorageV3 finds a "block-storage" endpoint as
+well as a "volumev3" one. The catalog can be bypassed entirely for some
+services with EndpointOverrides:
+
+	opts := gophercloud.EndpointOpts{
+		Region: "RegionOne",
+		EndpointOverrides: map[string]string{
+			"block-storage": "https://cinder.example.com/v3/",
+		},
+	}
+
+openstack.EndpointOverridesFromEnv reads these overrides from the
+OS_<SERVICE_TYPE>_ENDPOINT_OVERRIDE environment variables.
+
 Resources
 
 Resource structs are the domain models that services make use of in order
@@ -103,6 +117,91 @@ intermediary processing on each page, you can use the AllPages method:
 	allPages, err := servers.List(client, nil).AllPages()
 	allServers, err := servers.ExtractServers(allPages)
 
+To walk a collection item by item, fetching pages only as they are needed,
+use an Iterator:
+
+	it := servers.ListIterator(client, nil)
+	for it.Next() {
+		server := it.Value()
+		// ...
+	}
+	err := it.Err()
+
+Large collections can be walked faster by requesting the next page while the
+current one is handled, and without buffering them entirely by streaming
+their items:
+
+	items, errs := pagination.Stream(ctx, ports.List(client, nil).WithPrefetch(), ports.ExtractPorts, 100)
+	for port := range items {
+		// ...
+	}
+	err := <-errs
+
+Request Contexts
+
+Every request made through a ServiceClient can be bound to its own
+context.Context, which makes it possible to cancel or set a deadline on a
+single call without affecting other goroutines sharing the same client. Use
+WithContext to derive a per-call client:
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+
+Pagers and the WaitForStatusContext helpers accept a context as well:
+
+	err := servers.List(client, nil).WithContext(ctx).EachPage(handler)
+	err = servers.WaitForStatusContext(ctx, client, "{serverId}", "ACTIVE")
+
+Microversions
+
+Compute, Block Storage, Shared File Systems and Bare Metal service clients
+can negotiate the microversion they use with the service. The supported range
+is recorded on the service client, so that features needing a specific
+microversion can fail fast:
+
+	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+	err = utils.NegotiateMicroversion(client, "2.79")
+
+	if err := client.RequireMicroversion("2.60"); err != nil {
+		// err is a gophercloud.ErrMicroversionNotSupported
+	}
+
+Request IDs
+
+OpenStack services assign an ID to every request they handle. Results and
+errors expose it, which makes it easy to find the matching entry in the
+service logs:
+
+	r := ports.Create(client, opts)
+	log.Printf("port created by request %s", r.RequestID())
+
+	if e, ok := r.Err.(gophercloud.ResponseHeaderError); ok {
+		log.Printf("request %s failed", e.RequestID())
+	}
+
+To trace a single logical operation across several services, set a global
+request ID on the ProviderClient. It is sent with every request and recorded
+by each service next to its own request ID:
+
+	provider.GlobalRequestID = "req-" + uuid
+
+Token Caching
+
+Tokens can be shared between ProviderClients, and between runs of a program,
+through a TokenCache. openstack.Authenticate reuses a cached token issued for
+the same endpoint, user, credentials and scope, along with its service
+catalog. Setting TokenRefreshBefore additionally makes the client
+reauthenticate in the background shortly before its token expires:
+
+	provider, err := openstack.NewClient(opts.IdentityEndpoint)
+	provider.TokenCache = gophercloud.NewFileTokenCache("/var/cache/myapp/tokens")
+	provider.TokenRefreshBefore = 5 * time.Minute
+
+	opts.AllowReauth = true
+	err = openstack.Authenticate(provider, opts)
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
@@ -51,6 +51,13 @@ type EndpointOpts struct {
 	// Availability is not required, and defaults to AvailabilityPublic. Not all
 	// providers or services offer all Availability options.
 	Availability Availability
+
+	// EndpointOverrides [optional] maps service types to endpoint URLs that
+	// are used instead of looking the service up in the service catalog. Keys
+	// may be either the official service type or any of its aliases (e.g.,
+	// "block-storage" or "volumev3"), so that the same EndpointOpts can be
+	// passed to every service client factory function.
+	EndpointOverrides map[string]string
 }
 
 /*
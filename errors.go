@@ -99,6 +99,17 @@ func (e ErrUnexpectedResponseCode) GetStatusCode() int {
 	return e.Actual
 }
 
+// GetResponseHeader returns the header of the response that caused the error.
+func (e ErrUnexpectedResponseCode) GetResponseHeader() http.Header {
+	return e.ResponseHeader
+}
+
+// RequestID returns the ID the service assigned to the failed request, if the
+// response carried one. See Result.RequestID.
+func (e ErrUnexpectedResponseCode) RequestID() string {
+	return requestID(e.ResponseHeader)
+}
+
 // StatusCodeError is a convenience interface to easily allow access to the
 // status code field of the various ErrDefault* types.
 //
@@ -111,6 +122,19 @@ type StatusCodeError interface {
 	GetStatusCode() int
 }
 
+// ResponseHeaderError is a convenience interface to easily allow access to the
+// response header and request ID of the various ErrDefault* types, in the same
+// way as StatusCodeError:
+//
+//	if e, ok := err.(gophercloud.ResponseHeaderError); ok {
+//		log.Printf("request %s failed", e.RequestID())
+//	}
+type ResponseHeaderError interface {
+	Error() string
+	GetResponseHeader() http.Header
+	RequestID() string
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -292,6 +316,40 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+// ErrMicroversionNotSupported is returned when a service does not support the
+// microversion a caller requires.
+type ErrMicroversionNotSupported struct {
+	BaseError
+	ServiceType string
+	Required    string
+	Min         string
+	Max         string
+
+	// Current is the microversion the client sends its requests with, if
+	// it is the reason why Required cannot be used.
+	Current string
+}
+
+func (e ErrMicroversionNotSupported) Error() string {
+	if e.Current != "" {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s client uses microversion %s",
+			e.Required, e.ServiceType, e.Current,
+		)
+	} else if e.Max == "" {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s service does not support microversions",
+			e.Required, e.ServiceType,
+		)
+	} else {
+		e.DefaultErrString = fmt.Sprintf(
+			"Microversion %s is required, but the %s service only supports microversions %s to %s",
+			e.Required, e.ServiceType, e.Min, e.Max,
+		)
+	}
+	return e.choseErrString()
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -311,10 +369,43 @@ func (e ErrServiceNotFound) Error() string {
 // incorrectly.
 type ErrEndpointNotFound struct {
 	BaseError
+
+	// Types are the service types that were searched for, the requested one
+	// first, followed by its aliases.
+	Types []string
+
+	// Name, Region and Availability are the other search criteria.
+	Name         string
+	Region       string
+	Availability Availability
+
+	// Catalog describes the services the service catalog did contain.
+	Catalog []string
 }
 
 func (e ErrEndpointNotFound) Error() string {
 	e.DefaultErrString = "No suitable endpoint could be found in the service catalog."
+	if len(e.Types) > 0 {
+		e.DefaultErrString = fmt.Sprintf("No suitable endpoint could be found in the service catalog for service type %q", e.Types[0])
+		if len(e.Types) > 1 {
+			e.DefaultErrString += fmt.Sprintf(" (or its aliases %s)", strings.Join(e.Types[1:], ", "))
+		}
+		if e.Name != "" {
+			e.DefaultErrString += fmt.Sprintf(", name %q", e.Name)
+		}
+		if e.Region != "" {
+			e.DefaultErrString += fmt.Sprintf(", region %q", e.Region)
+		}
+		if e.Availability != "" {
+			e.DefaultErrString += fmt.Sprintf(", interface %q", e.Availability)
+		}
+		if len(e.Catalog) > 0 {
+			e.DefaultErrString += ". The catalog contains: " + strings.Join(e.Catalog, "; ")
+		} else {
+			e.DefaultErrString += ". The catalog is empty"
+		}
+		e.DefaultErrString += "."
+	}
 	return e.choseErrString()
 }
 
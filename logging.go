@@ -0,0 +1,366 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LogRecord is a structured description of a logical request and its
+// outcome, as emitted by the logging middleware. Headers and bodies are
+// redacted according to the LoggingOpts the middleware was created with.
+type LogRecord struct {
+	// Method and URL identify the request.
+	Method string
+	URL    string
+
+	// ServiceType and Microversion describe the ServiceClient that issued
+	// the request. They are empty for requests issued through a
+	// ProviderClient directly.
+	ServiceType  string
+	Microversion string
+
+	// RequestHeaders holds the headers set through RequestOpts.MoreHeaders
+	// and ServiceClient.MoreHeaders. Authentication headers added by the
+	// ProviderClient are never included.
+	RequestHeaders http.Header
+
+	// RequestBody is the JSON request body. It is empty for requests without
+	// a JSON body or when LoggingOpts.LogBodies is false.
+	RequestBody string
+
+	// StatusCode is the HTTP status code of the response, or 0 if no
+	// response was received.
+	StatusCode int
+
+	// RequestID is the OpenStack request ID returned by the service, if any.
+	RequestID string
+
+	// ResponseHeaders holds the headers of the response.
+	ResponseHeaders http.Header
+
+	// ResponseBody is the JSON response body. It is empty for responses that
+	// were not decoded as JSON or when LoggingOpts.LogBodies is false.
+	ResponseBody string
+
+	// Duration is the time spent performing the request, including retries
+	// and reauthentication.
+	Duration time.Duration
+
+	// Err is the error returned by the request, if any.
+	Err error
+}
+
+// Logger receives the records emitted by the logging middleware. Log may be
+// called concurrently.
+type Logger interface {
+	Log(record LogRecord)
+}
+
+// LoggerFunc is an adapter to allow the use of ordinary functions as Logger.
+type LoggerFunc func(record LogRecord)
+
+// Log calls f(record).
+func (f LoggerFunc) Log(record LogRecord) {
+	f(record)
+}
+
+// RedactedValue replaces the value of redacted headers and body fields.
+const RedactedValue = "***"
+
+// DefaultRedactedHeaders lists the headers whose values are always redacted
+// by the logging middleware. Header names are matched case-insensitively.
+var DefaultRedactedHeaders = []string{
+	"X-Auth-Token",
+	"X-Auth-Key",
+	"X-Service-Token",
+	"X-Storage-Token",
+	"X-Subject-Token",
+	"X-Account-Meta-Temp-Url-Key",
+	"X-Account-Meta-Temp-Url-Key-2",
+	"X-Container-Meta-Temp-Url-Key",
+	"X-Container-Meta-Temp-Url-Key-2",
+	"Openstack-Auth-Receipt",
+	"Set-Cookie",
+}
+
+// BodyRedaction describes a field of a JSON body to redact.
+type BodyRedaction struct {
+	// ServiceType restricts the redaction to requests issued by a
+	// ServiceClient of this type, for example "compute". An empty
+	// ServiceType applies to all requests.
+	ServiceType string
+
+	// Path is the list of keys leading to the field, starting from the root
+	// of the body. The "*" element matches any key of an object and any
+	// element of an array.
+	Path []string
+}
+
+// DefaultBodyRedactions lists the body fields that are always redacted by the
+// logging middleware.
+var DefaultBodyRedactions = []BodyRedaction{
+	// Keystone authentication requests and credential management.
+	{Path: []string{"auth", "identity", "password", "user", "password"}},
+	{Path: []string{"auth", "identity", "totp", "user", "passcode"}},
+	{Path: []string{"auth", "identity", "application_credential", "secret"}},
+	{Path: []string{"auth", "identity", "token", "id"}},
+	{Path: []string{"auth", "identity", "*", "secret"}},
+	{Path: []string{"auth", "passwordCredentials", "password"}},
+	{Path: []string{"auth", "token", "id"}},
+	{Path: []string{"user", "password"}},
+	{Path: []string{"user", "original_password"}},
+	{Path: []string{"application_credential", "secret"}},
+	{Path: []string{"credential", "blob"}},
+	{Path: []string{"credential", "secret"}},
+
+	// Nova administrative passwords.
+	{ServiceType: "compute", Path: []string{"server", "adminPass"}},
+	{ServiceType: "compute", Path: []string{"rebuild", "adminPass"}},
+	{ServiceType: "compute", Path: []string{"changePassword", "adminPass"}},
+	{ServiceType: "compute", Path: []string{"rescue", "adminPass"}},
+	{ServiceType: "compute", Path: []string{"evacuate", "adminPass"}},
+	{ServiceType: "compute", Path: []string{"adminPass"}},
+
+	// Barbican secret payloads.
+	{ServiceType: "key-manager", Path: []string{"payload"}},
+
+	// Trove user and root passwords.
+	{ServiceType: "database", Path: []string{"users", "*", "password"}},
+	{ServiceType: "database", Path: []string{"user", "password"}},
+	{ServiceType: "database", Path: []string{"instance", "users", "*", "password"}},
+}
+
+// LoggingOpts configures the middleware returned by NewLoggingMiddleware.
+type LoggingOpts struct {
+	// LogBodies enables logging of JSON request and response bodies.
+	LogBodies bool
+
+	// RedactHeaders lists headers to redact in addition to
+	// DefaultRedactedHeaders.
+	RedactHeaders []string
+
+	// RedactBodies lists body fields to redact in addition to
+	// DefaultBodyRedactions.
+	RedactBodies []BodyRedaction
+
+	// LogCatalogs enables logging of the response bodies of token requests
+	// that include a service catalog, which are large and otherwise omitted.
+	LogCatalogs bool
+}
+
+// NewLoggingMiddleware returns a Middleware that emits a LogRecord to logger
+// for every request, once the request has completed:
+//
+//	provider.Use(gophercloud.NewLoggingMiddleware(logger, gophercloud.LoggingOpts{
+//		LogBodies: true,
+//		RedactBodies: []gophercloud.BodyRedaction{
+//			{ServiceType: "orchestration", Path: []string{"parameters", "db_password"}},
+//		},
+//	}))
+func NewLoggingMiddleware(logger Logger, opts LoggingOpts) Middleware {
+	redactHeaders := make(map[string]bool)
+	for _, h := range DefaultRedactedHeaders {
+		redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, h := range opts.RedactHeaders {
+		redactHeaders[http.CanonicalHeaderKey(h)] = true
+	}
+	redactBodies := append(append([]BodyRedaction{}, DefaultBodyRedactions...), opts.RedactBodies...)
+
+	return func(next RequestHandler) RequestHandler {
+		return func(info *RequestInfo) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(info)
+
+			record := LogRecord{
+				Method:       info.Method,
+				URL:          info.URL,
+				ServiceType:  info.ServiceType,
+				Microversion: info.Microversion,
+				Duration:     time.Since(start),
+				Err:          err,
+			}
+
+			if info.Options != nil {
+				if len(info.Options.MoreHeaders) > 0 {
+					h := make(http.Header)
+					for k, v := range info.Options.MoreHeaders {
+						if v != "" {
+							h.Set(k, v)
+						}
+					}
+					record.RequestHeaders = redactHeaderValues(h, redactHeaders)
+				}
+				if opts.LogBodies && info.Options.JSONBody != nil {
+					record.RequestBody = redactJSON(info.Options.JSONBody, info.ServiceType, redactBodies)
+				}
+			}
+
+			var respHeader http.Header
+			if e, ok := err.(responseErrorDetailer); ok {
+				details := e.responseErrorDetails()
+				record.StatusCode = details.Actual
+				respHeader = details.ResponseHeader
+				if opts.LogBodies && len(details.Body) > 0 {
+					var body interface{}
+					if json.Unmarshal(details.Body, &body) == nil {
+						record.ResponseBody = redactJSON(body, info.ServiceType, redactBodies)
+					} else {
+						record.ResponseBody = string(details.Body)
+					}
+				}
+			} else if resp != nil {
+				record.StatusCode = resp.StatusCode
+				respHeader = resp.Header
+				if opts.LogBodies && err == nil && info.Options != nil && info.Options.JSONResponse != nil {
+					if opts.LogCatalogs || !hasTokenCatalog(info.Options.JSONResponse) {
+						record.ResponseBody = redactJSON(info.Options.JSONResponse, info.ServiceType, redactBodies)
+					}
+				}
+			}
+			if respHeader != nil {
+				record.RequestID = requestID(respHeader)
+				record.ResponseHeaders = redactHeaderValues(respHeader, redactHeaders)
+			}
+
+			logger.Log(record)
+			return resp, err
+		}
+	}
+}
+
+// responseErrorDetailer is implemented by ErrUnexpectedResponseCode and every
+// error type embedding it.
+type responseErrorDetailer interface {
+	responseErrorDetails() ErrUnexpectedResponseCode
+}
+
+func (e ErrUnexpectedResponseCode) responseErrorDetails() ErrUnexpectedResponseCode {
+	return e
+}
+
+// RedactHeaders returns a copy of h in which the values of the headers listed
+// in DefaultRedactedHeaders and names are replaced by RedactedValue. It is
+// meant for loggers that do not use the logging middleware.
+func RedactHeaders(h http.Header, names ...string) http.Header {
+	redact := make(map[string]bool)
+	for _, name := range append(append([]string{}, DefaultRedactedHeaders...), names...) {
+		redact[http.CanonicalHeaderKey(name)] = true
+	}
+	return redactHeaderValues(h, redact)
+}
+
+// RedactJSONBody returns body with the fields matched by the applicable
+// DefaultBodyRedactions and redactions replaced by RedactedValue. The body
+// of a token request that includes a service catalog is replaced by an empty
+// string, and a body that is not JSON is returned as is. It is meant for
+// loggers that do not use the logging middleware.
+func RedactJSONBody(body []byte, serviceType string, redactions ...BodyRedaction) string {
+	var generic interface{}
+	if err := json.Unmarshal(body, &generic); err != nil {
+		return string(body)
+	}
+	if hasTokenCatalog(generic) {
+		return ""
+	}
+	return redactJSON(generic, serviceType, append(append([]BodyRedaction{}, DefaultBodyRedactions...), redactions...))
+}
+
+// hasTokenCatalog reports whether body is the response of an identity v2 or
+// v3 token request that includes a service catalog.
+func hasTokenCatalog(body interface{}) bool {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return false
+	}
+	var s struct {
+		Token *struct {
+			Catalog json.RawMessage `json:"catalog"`
+		} `json:"token"`
+		Access *struct {
+			ServiceCatalog json.RawMessage `json:"serviceCatalog"`
+		} `json:"access"`
+	}
+	if json.Unmarshal(b, &s) != nil {
+		return false
+	}
+	return (s.Token != nil && s.Token.Catalog != nil) || (s.Access != nil && s.Access.ServiceCatalog != nil)
+}
+
+// redactHeaderValues returns a copy of h in which the values of the headers
+// listed in redact are replaced by RedactedValue.
+func redactHeaderValues(h http.Header, redact map[string]bool) http.Header {
+	redacted := make(http.Header, len(h))
+	for k, v := range h {
+		if redact[http.CanonicalHeaderKey(k)] {
+			redacted[k] = []string{RedactedValue}
+			continue
+		}
+		redacted[k] = append([]string(nil), v...)
+	}
+	return redacted
+}
+
+// redactJSON renders body as JSON with the fields matched by the applicable
+// redactions replaced by RedactedValue.
+func redactJSON(body interface{}, serviceType string, redactions []BodyRedaction) string {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return ""
+	}
+
+	// Work on a generic copy so that the caller's value is never modified.
+	var generic interface{}
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return string(b)
+	}
+
+	for _, r := range redactions {
+		if r.ServiceType != "" && !strings.EqualFold(r.ServiceType, serviceType) {
+			continue
+		}
+		redactPath(generic, r.Path)
+	}
+
+	b, err = json.Marshal(generic)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func redactPath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	key, rest := path[0], path[1:]
+
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for k, child := range node {
+			if key != "*" && k != key {
+				continue
+			}
+			if len(rest) == 0 {
+				if child != nil {
+					node[k] = RedactedValue
+				}
+				continue
+			}
+			redactPath(child, rest)
+		}
+	case []interface{}:
+		if key != "*" {
+			return
+		}
+		for i, child := range node {
+			if len(rest) == 0 {
+				node[i] = RedactedValue
+				continue
+			}
+			redactPath(child, rest)
+		}
+	}
+}
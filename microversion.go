@@ -0,0 +1,140 @@
+package gophercloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// microversion is a parsed "major.minor" microversion.
+type microversion struct {
+	major, minor int
+}
+
+func parseMicroversion(v string) (microversion, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) != 2 {
+		return microversion{}, fmt.Errorf("Invalid microversion %q", v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return microversion{}, fmt.Errorf("Invalid microversion %q", v)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return microversion{}, fmt.Errorf("Invalid microversion %q", v)
+	}
+	return microversion{major: major, minor: minor}, nil
+}
+
+func (m microversion) less(other microversion) bool {
+	if m.major != other.major {
+		return m.major < other.major
+	}
+	return m.minor < other.minor
+}
+
+// CompareMicroversions compares two microversions of the form "major.minor".
+// It returns -1 if a is older than b, 0 if they are equal and 1 if a is newer
+// than b.
+func CompareMicroversions(a, b string) (int, error) {
+	ma, err := parseMicroversion(a)
+	if err != nil {
+		return 0, err
+	}
+	mb, err := parseMicroversion(b)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case ma.less(mb):
+		return -1, nil
+	case mb.less(ma):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// RequireMicroversion returns an ErrMicroversionNotSupported error if the
+// service does not support microversion v, according to MinMicroversion and
+// MaxMicroversion, or if v is newer than the Microversion the client sends
+// its requests with. It returns nil if the supported range and Microversion
+// are unknown, in which case the service itself rejects unsupported
+// microversions.
+func (client *ServiceClient) RequireMicroversion(v string) error {
+	if client.usesMicroversion() {
+		if c, err := CompareMicroversions(v, client.Microversion); err != nil {
+			return err
+		} else if c > 0 {
+			return ErrMicroversionNotSupported{
+				ServiceType: client.Type,
+				Required:    v,
+				Min:         client.MinMicroversion,
+				Max:         client.MaxMicroversion,
+				Current:     client.Microversion,
+			}
+		}
+	}
+
+	if client.MinMicroversion == "" || client.MaxMicroversion == "" {
+		return nil
+	}
+
+	if c, err := CompareMicroversions(v, client.MinMicroversion); err != nil {
+		return err
+	} else if c < 0 {
+		return client.errMicroversionNotSupported(v)
+	}
+	if c, err := CompareMicroversions(v, client.MaxMicroversion); err != nil {
+		return err
+	} else if c > 0 {
+		return client.errMicroversionNotSupported(v)
+	}
+	return nil
+}
+
+// HighestMicroversion returns the highest microversion supported by the
+// service that is not newer than upTo, nor than the Microversion the client
+// sends its requests with, if set. It returns an ErrMicroversionNotSupported
+// error if even MinMicroversion is newer than upTo. If the supported range is
+// unknown, upTo is only capped by Microversion.
+func (client *ServiceClient) HighestMicroversion(upTo string) (string, error) {
+	if client.usesMicroversion() {
+		if c, err := CompareMicroversions(upTo, client.Microversion); err != nil {
+			return "", err
+		} else if c > 0 {
+			upTo = client.Microversion
+		}
+	}
+
+	if client.MinMicroversion == "" || client.MaxMicroversion == "" {
+		return upTo, nil
+	}
+
+	if c, err := CompareMicroversions(upTo, client.MinMicroversion); err != nil {
+		return "", err
+	} else if c < 0 {
+		return "", client.errMicroversionNotSupported(upTo)
+	}
+	if c, err := CompareMicroversions(upTo, client.MaxMicroversion); err != nil {
+		return "", err
+	} else if c > 0 {
+		return client.MaxMicroversion, nil
+	}
+	return upTo, nil
+}
+
+// usesMicroversion returns true if the client sends its requests with a
+// given microversion, rather than the default or the latest one.
+func (client *ServiceClient) usesMicroversion() bool {
+	return client.Microversion != "" && client.Microversion != "latest"
+}
+
+func (client *ServiceClient) errMicroversionNotSupported(v string) error {
+	return ErrMicroversionNotSupported{
+		ServiceType: client.Type,
+		Required:    v,
+		Min:         client.MinMicroversion,
+		Max:         client.MaxMicroversion,
+	}
+}
@@ -0,0 +1,92 @@
+package gophercloud
+
+import (
+	"net/http"
+)
+
+// RequestInfo describes a single logical request as seen by a Middleware.
+//
+// A logical request covers everything that happens during one call to
+// ProviderClient.Request or ServiceClient.Request, including automatic
+// reauthentication and retries.
+type RequestInfo struct {
+	// Method is the HTTP verb of the request.
+	Method string
+
+	// URL is the full URL of the request.
+	URL string
+
+	// Options are the options the request will be issued with. Middleware may
+	// modify them, for example to add headers through Options.MoreHeaders.
+	// Once the request has completed, Options.JSONResponse holds the decoded
+	// response body, if one was requested.
+	Options *RequestOpts
+
+	// ServiceType is the type of the ServiceClient that issued the request,
+	// for example "compute" or "network". It is empty for requests issued
+	// directly through a ProviderClient.
+	ServiceType string
+
+	// Microversion is the microversion of the ServiceClient that issued the
+	// request, if any.
+	Microversion string
+}
+
+// RequestHandler performs the logical request described by info.
+type RequestHandler func(info *RequestInfo) (*http.Response, error)
+
+// Middleware wraps a RequestHandler with additional behavior, such as
+// logging, metrics, header injection or request signing. A Middleware must
+// call next to perform the request, unless it decides to short-circuit it,
+// and should return the response and error returned by next.
+//
+// The response and error seen by a Middleware are the final ones: the error
+// is already mapped to ErrDefault404, ErrDefault503 and so on, and reflects
+// the ErrorContext of the request.
+type Middleware func(next RequestHandler) RequestHandler
+
+// Use appends middleware to the chain of the ProviderClient. The first
+// middleware added is the outermost one: it sees the request first and the
+// response last.
+//
+// Use is not safe to call concurrently with requests.
+func (client *ProviderClient) Use(middleware ...Middleware) {
+	client.Middleware = append(client.Middleware, middleware...)
+}
+
+// Use appends middleware to the chain of the ServiceClient. Middleware of a
+// ServiceClient runs inside the middleware of its ProviderClient.
+//
+// Use is not safe to call concurrently with requests.
+func (client *ServiceClient) Use(middleware ...Middleware) {
+	client.Middleware = append(client.Middleware, middleware...)
+}
+
+// chainMiddleware wraps handler so that the first element of each list is the
+// outermost middleware, and every element of outer runs before any element of
+// inner.
+func chainMiddleware(handler RequestHandler, outer, inner []Middleware) RequestHandler {
+	for i := len(inner) - 1; i >= 0; i-- {
+		handler = inner[i](handler)
+	}
+	for i := len(outer) - 1; i >= 0; i-- {
+		handler = outer[i](handler)
+	}
+	return handler
+}
+
+// dispatch runs the logical request described by info through the middleware
+// chains of the ProviderClient and, if given, of the ServiceClient.
+func (client *ProviderClient) dispatch(info *RequestInfo, serviceMiddleware []Middleware) (*http.Response, error) {
+	handler := func(info *RequestInfo) (*http.Response, error) {
+		return client.doRequest(info.Method, info.URL, info.Options, &requestState{
+			hasReauthenticated: false,
+		})
+	}
+
+	if len(client.Middleware) == 0 && len(serviceMiddleware) == 0 {
+		return handler(info)
+	}
+
+	return chainMiddleware(handler, client.Middleware, serviceMiddleware)(info)
+}
@@ -0,0 +1,248 @@
+package openstack
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	tokens3 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/utils"
+)
+
+/*
+AuthenticateWithProvider authenticates client with the token and the
+EndpointLocator obtained by provider. If provider can reauthenticate, the
+ReauthFunc of client is set to obtain a new token from provider, and the token
+is refreshed in the background according to the client's TokenRefreshBefore.
+
+If the client has a TokenCache and provider implements
+gophercloud.TokenCacheKeyer, a token stored in the cache under the key of
+provider is reused as long as it does not expire within the client's
+TokenRefreshBefore, and new tokens are stored in it. If provider also
+implements gophercloud.TokenCacheVerifier, the cached token must have been
+obtained with the same secrets. Only the tokens of the identity v2 and v3 token
+requests can be cached.
+
+Example to Authenticate with a Custom Source of Tokens
+
+	provider, err := openstack.NewClient("https://keystone.example.com:5000/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	// vaultAuthProvider implements gophercloud.AuthProvider.
+	err = openstack.AuthenticateWithProvider(provider, vaultAuthProvider{path: "openstack/creds/dev"})
+	if err != nil {
+		panic(err)
+	}
+*/
+func AuthenticateWithProvider(client *gophercloud.ProviderClient, provider gophercloud.AuthProvider) error {
+	var key string
+	if keyer, ok := provider.(gophercloud.TokenCacheKeyer); ok && client.TokenCache != nil {
+		key = keyer.TokenCacheKey()
+	}
+
+	if key == "" || !useCachedToken(client, provider, key) {
+		result, locator, err := provider.Authenticate(client)
+		if err != nil {
+			return err
+		}
+		if err := client.SetTokenAndAuthResult(result); err != nil {
+			return err
+		}
+		client.EndpointLocator = locator
+		if key != "" {
+			storeToken(client, provider, key)
+		}
+	}
+
+	if provider.CanReauth() {
+		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
+		// with the token and reauth func zeroed out, so that the provider authenticates only once
+		tac := *client
+		tac.SetThrowaway(true)
+		tac.ReauthFunc = nil
+		tac.SetTokenAndAuthResult(nil)
+		client.ReauthFunc = func() error {
+			result, err := provider.Refresh(&tac)
+			if err != nil {
+				return err
+			}
+			if err := tac.SetTokenAndAuthResult(result); err != nil {
+				return err
+			}
+			client.CopyTokenFrom(&tac)
+			if key != "" {
+				storeToken(client, provider, key)
+			}
+			return nil
+		}
+	}
+
+	client.ScheduleTokenRefresh()
+	return nil
+}
+
+// RevokeToken revokes the token client obtained from provider, and removes it
+// from client and from its TokenCache. The background refresh of the token is
+// stopped, and client no longer reauthenticates.
+func RevokeToken(client *gophercloud.ProviderClient, provider gophercloud.AuthProvider) error {
+	if err := provider.Revoke(client, client.GetAuthResult()); err != nil {
+		return err
+	}
+
+	client.StopTokenRefresh()
+	client.ReauthFunc = nil
+	if keyer, ok := provider.(gophercloud.TokenCacheKeyer); ok && client.TokenCache != nil {
+		if key := keyer.TokenCacheKey(); key != "" {
+			_ = client.TokenCache.Delete(key)
+		}
+	}
+	return client.SetTokenAndAuthResult(nil)
+}
+
+// identityAuthProvider is the gophercloud.AuthProvider of the identity
+// service.
+type identityAuthProvider struct {
+	// version is the version of the identity service to authenticate
+	// against, or an empty string to choose the most recent one supported at
+	// options.IdentityEndpoint.
+	version string
+
+	options   gophercloud.AuthOptions
+	v3Options tokens3.AuthOptionsBuilder
+	eo        gophercloud.EndpointOpts
+
+	// mut protects chosen and endpoint, the version and the endpoint chosen
+	// by the first authentication, when version is empty.
+	mut      sync.Mutex
+	chosen   string
+	endpoint string
+}
+
+// NewAuthProvider returns a gophercloud.AuthProvider that authenticates with
+// options against the most recent identity service supported at
+// options.IdentityEndpoint. Its tokens may be cached, unless options.TokenID
+// is set.
+func NewAuthProvider(options gophercloud.AuthOptions) gophercloud.AuthProvider {
+	return &identityAuthProvider{options: options}
+}
+
+// NewAuthProviderV2 returns a gophercloud.AuthProvider that authenticates
+// with options against the identity v2 service.
+func NewAuthProviderV2(options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) gophercloud.AuthProvider {
+	return &identityAuthProvider{version: v2, options: options, eo: eo}
+}
+
+// NewAuthProviderV3 returns a gophercloud.AuthProvider that authenticates
+// with options against the identity v3 service.
+func NewAuthProviderV3(options tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) gophercloud.AuthProvider {
+	return &identityAuthProvider{version: v3, v3Options: options, eo: eo}
+}
+
+func (p *identityAuthProvider) Authenticate(client *gophercloud.ProviderClient) (gophercloud.AuthResult, gophercloud.EndpointLocator, error) {
+	c := *client
+	c.ReauthFunc = nil
+	if err := p.authenticate(&c); err != nil {
+		return nil, nil, err
+	}
+	return c.GetAuthResult(), c.EndpointLocator, nil
+}
+
+func (p *identityAuthProvider) CanReauth() bool {
+	if p.version == v3 {
+		return p.v3Options.CanReauth()
+	}
+	return p.options.AllowReauth
+}
+
+func (p *identityAuthProvider) Refresh(client *gophercloud.ProviderClient) (gophercloud.AuthResult, error) {
+	result, _, err := p.Authenticate(client)
+	return result, err
+}
+
+// Revoke revokes identity v3 tokens. Identity v2 does not allow users to
+// revoke their own tokens, so that v2 tokens are left to expire.
+func (p *identityAuthProvider) Revoke(client *gophercloud.ProviderClient, result gophercloud.AuthResult) error {
+	switch result.(type) {
+	case tokens3.CreateResult, tokens3.GetResult:
+	default:
+		return nil
+	}
+
+	tokenID, err := result.ExtractTokenID()
+	if err != nil {
+		return err
+	}
+
+	v3Client, err := NewIdentityV3(client, p.eo)
+	if err != nil {
+		return err
+	}
+	p.mut.Lock()
+	if p.chosen == v3 {
+		v3Client.Endpoint = p.endpoint
+	}
+	p.mut.Unlock()
+
+	return tokens3.Revoke(v3Client, tokenID).Err
+}
+
+// TokenCacheKey implements gophercloud.TokenCacheKeyer. Only the tokens of
+// the providers returned by NewAuthProvider are cached.
+func (p *identityAuthProvider) TokenCacheKey() string {
+	if p.version != "" || p.options.TokenID != "" {
+		return ""
+	}
+	return gophercloud.TokenCacheKey(p.options)
+}
+
+// TokenCacheSecretHash implements gophercloud.TokenCacheVerifier.
+func (p *identityAuthProvider) TokenCacheSecretHash() (string, error) {
+	return gophercloud.HashTokenCacheSecret(p.options)
+}
+
+// VerifyTokenCacheSecret implements gophercloud.TokenCacheVerifier.
+func (p *identityAuthProvider) VerifyTokenCacheSecret(hash string) bool {
+	return gophercloud.VerifyTokenCacheSecret(p.options, hash)
+}
+
+func (p *identityAuthProvider) authenticate(client *gophercloud.ProviderClient) error {
+	switch p.version {
+	case v2:
+		return v2auth(client, "", p.options, p.eo)
+	case v3:
+		return v3auth(client, "", p.v3Options, p.eo)
+	}
+
+	p.mut.Lock()
+	chosen, endpoint := p.chosen, p.endpoint
+	p.mut.Unlock()
+
+	if chosen == "" {
+		versions := []*utils.Version{
+			{ID: v2, Priority: 20, Suffix: "/v2.0/"},
+			{ID: v3, Priority: 30, Suffix: "/v3/"},
+		}
+
+		version, url, err := utils.ChooseVersion(client, versions)
+		if err != nil {
+			return err
+		}
+		chosen, endpoint = version.ID, url
+
+		p.mut.Lock()
+		p.chosen, p.endpoint = chosen, endpoint
+		p.mut.Unlock()
+	}
+
+	switch chosen {
+	case v2:
+		return v2auth(client, endpoint, p.options, gophercloud.EndpointOpts{})
+	case v3:
+		return v3auth(client, endpoint, &p.options, gophercloud.EndpointOpts{})
+	default:
+		// The switch statement must be out of date from the versions list.
+		return fmt.Errorf("Unrecognized identity version: %s", chosen)
+	}
+}
@@ -0,0 +1,72 @@
+package httpbasic
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// authResult is the gophercloud.AuthResult of a "http_basic" bare metal
+// service. It authenticates requests with the Authorization header rather
+// than with a token.
+type authResult struct {
+	authorization string
+}
+
+func (r authResult) ExtractTokenID() (string, error) {
+	return "", nil
+}
+
+func (r authResult) AuthHeaders() map[string]string {
+	return map[string]string{"Authorization": r.authorization}
+}
+
+// authProvider is a gophercloud.AuthProvider for "http_basic" bare metal
+// services.
+type authProvider struct {
+	endpoint string
+	result   authResult
+}
+
+// NewAuthProvider returns a gophercloud.AuthProvider for a "http_basic" bare
+// metal service. It authenticates requests with eo.IronicUser and
+// eo.IronicUserPassword and locates the bare metal service at
+// eo.IronicEndpoint, so that the ProviderClients it authenticates may be used
+// with openstack.NewBareMetalV1.
+func NewAuthProvider(eo EndpointOpts) (gophercloud.AuthProvider, error) {
+	if eo.IronicEndpoint == "" {
+		return nil, fmt.Errorf("IronicEndpoint is required")
+	}
+	if eo.IronicUser == "" || eo.IronicUserPassword == "" {
+		return nil, fmt.Errorf("User and Password are required")
+	}
+
+	token := []byte(eo.IronicUser + ":" + eo.IronicUserPassword)
+	return authProvider{
+		endpoint: gophercloud.NormalizeURL(eo.IronicEndpoint),
+		result:   authResult{authorization: "Basic " + base64.StdEncoding.EncodeToString(token)},
+	}, nil
+}
+
+func (p authProvider) Authenticate(client *gophercloud.ProviderClient) (gophercloud.AuthResult, gophercloud.EndpointLocator, error) {
+	locator := func(opts gophercloud.EndpointOpts) (string, error) {
+		if opts.Type != "baremetal" {
+			return "", &gophercloud.ErrEndpointNotFound{Types: []string{opts.Type}}
+		}
+		return p.endpoint, nil
+	}
+	return p.result, locator, nil
+}
+
+func (p authProvider) CanReauth() bool {
+	return false
+}
+
+func (p authProvider) Refresh(client *gophercloud.ProviderClient) (gophercloud.AuthResult, error) {
+	return p.result, nil
+}
+
+func (p authProvider) Revoke(client *gophercloud.ProviderClient, result gophercloud.AuthResult) error {
+	return nil
+}
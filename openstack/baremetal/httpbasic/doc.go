@@ -14,5 +14,24 @@ Example of obtaining and using a client:
 
 	client.Microversion = "1.50"
 	nodes.ListDetail(client, nodes.listOpts{})
+
+Example of authenticating a ProviderClient:
+
+	provider, err := httpbasic.NewAuthProvider(httpbasic.EndpointOpts{
+		IronicEndpoint:     "http://localhost:6385/v1/",
+		IronicUser:         "myUser",
+		IronicUserPassword: "myPassword",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	providerClient := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(providerClient, provider)
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := openstack.NewBareMetalV1(providerClient, gophercloud.EndpointOpts{})
 */
 package httpbasic
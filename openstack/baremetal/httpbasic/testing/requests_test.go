@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"testing"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetal/httpbasic"
 	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 )
@@ -34,3 +36,28 @@ func TestHttpBasic(t *testing.T) {
 	th.AssertEquals(t, "IronicEndpoint is required", err.Error())
 
 }
+
+func TestHttpBasicProvider(t *testing.T) {
+	provider, err := httpbasic.NewAuthProvider(httpbasic.EndpointOpts{
+		IronicEndpoint:     "http://ironic:6385/v1",
+		IronicUser:         "myUser",
+		IronicUserPassword: "myPasswd",
+	})
+	th.AssertNoErr(t, err)
+
+	client := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+
+	encToken := base64.StdEncoding.EncodeToString([]byte("myUser:myPasswd"))
+	th.AssertDeepEquals(t, map[string]string{"Authorization": "Basic " + encToken}, client.AuthenticatedHeaders())
+
+	baremetal, err := openstack.NewBareMetalV1(client, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http://ironic:6385/v1/", baremetal.Endpoint)
+
+	_, err = httpbasic.NewAuthProvider(httpbasic.EndpointOpts{
+		IronicEndpoint: "http://ironic:6385/v1",
+	})
+	th.AssertEquals(t, "User and Password are required", err.Error())
+}
@@ -0,0 +1,54 @@
+package noauth
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// authResult is the gophercloud.AuthResult of a "noauth" bare metal service,
+// which requires no token.
+type authResult struct{}
+
+func (r authResult) ExtractTokenID() (string, error) {
+	return "", nil
+}
+
+// authProvider is a gophercloud.AuthProvider for "noauth" bare metal
+// services.
+type authProvider struct {
+	endpoint string
+}
+
+// NewAuthProvider returns a gophercloud.AuthProvider for a "noauth" bare
+// metal service. It obtains no token and locates the bare metal service at
+// eo.IronicEndpoint, so that the ProviderClients it authenticates may be used
+// with openstack.NewBareMetalV1.
+func NewAuthProvider(eo EndpointOpts) (gophercloud.AuthProvider, error) {
+	if eo.IronicEndpoint == "" {
+		return nil, fmt.Errorf("IronicEndpoint is required")
+	}
+	return authProvider{endpoint: gophercloud.NormalizeURL(eo.IronicEndpoint)}, nil
+}
+
+func (p authProvider) Authenticate(client *gophercloud.ProviderClient) (gophercloud.AuthResult, gophercloud.EndpointLocator, error) {
+	locator := func(opts gophercloud.EndpointOpts) (string, error) {
+		if opts.Type != "baremetal" {
+			return "", &gophercloud.ErrEndpointNotFound{Types: []string{opts.Type}}
+		}
+		return p.endpoint, nil
+	}
+	return authResult{}, locator, nil
+}
+
+func (p authProvider) CanReauth() bool {
+	return false
+}
+
+func (p authProvider) Refresh(client *gophercloud.ProviderClient) (gophercloud.AuthResult, error) {
+	return authResult{}, nil
+}
+
+func (p authProvider) Revoke(client *gophercloud.ProviderClient, result gophercloud.AuthResult) error {
+	return nil
+}
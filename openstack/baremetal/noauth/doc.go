@@ -13,5 +13,22 @@ Example of obtaining and using a client:
 	client.Microversion = "1.50"
 
 	nodes.ListDetail(client, nodes.ListOpts{})
+
+Example of authenticating a ProviderClient:
+
+	provider, err := noauth.NewAuthProvider(noauth.EndpointOpts{
+		IronicEndpoint: "http://localhost:6385/v1/",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	providerClient := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(providerClient, provider)
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := openstack.NewBareMetalV1(providerClient, gophercloud.EndpointOpts{})
 */
 package noauth
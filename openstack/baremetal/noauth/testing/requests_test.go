@@ -3,6 +3,8 @@ package testing
 import (
 	"testing"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetal/noauth"
 	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 )
@@ -14,3 +16,26 @@ func TestNoAuth(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, "", noauthClient.TokenID)
 }
+
+func TestNoAuthProvider(t *testing.T) {
+	provider, err := noauth.NewAuthProvider(noauth.EndpointOpts{
+		IronicEndpoint: "http://ironic:6385/v1",
+	})
+	th.AssertNoErr(t, err)
+
+	client := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(client.AuthenticatedHeaders()))
+
+	baremetal, err := openstack.NewBareMetalV1(client, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http://ironic:6385/v1/", baremetal.Endpoint)
+
+	_, err = openstack.NewComputeV2(client, gophercloud.EndpointOpts{})
+	_, ok := err.(*gophercloud.ErrEndpointNotFound)
+	th.AssertEquals(t, true, ok)
+
+	_, err = noauth.NewAuthProvider(noauth.EndpointOpts{})
+	th.AssertEquals(t, "IronicEndpoint is required", err.Error())
+}
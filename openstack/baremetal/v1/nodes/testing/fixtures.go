@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetal/v1/nodes"
@@ -864,6 +865,19 @@ func HandleNodeGetSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleNodeGetDeployFailedSuccessfully sets up the test server to respond to
+// a node Get request with a node whose deployment failed.
+func HandleNodeGetDeployFailedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/nodes/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		body := strings.Replace(SingleNodeBody, `"provision_state": "enroll"`, `"provision_state": "deploy failed"`, 1)
+		body = strings.Replace(body, `"last_error": null`, `"last_error": "Timeout reached while waiting for callback"`, 1)
+		fmt.Fprint(w, body)
+	})
+}
+
 func HandleNodeUpdateSuccessfully(t *testing.T, response string) {
 	th.Mux.HandleFunc("/nodes/1234asdf", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "PATCH")
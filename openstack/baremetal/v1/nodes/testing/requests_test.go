@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"testing"
 
 	"github.com/yogeshwargnanasekaran/gophercloud"
@@ -522,3 +523,25 @@ func TestToRAIDConfigMap(t *testing.T) {
 		})
 	}
 }
+
+func TestProvisionStateWaiter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeGetSuccessfully(t)
+
+	node, err := nodes.NewProvisionStateWaiter(client.ServiceClient(), "1234asdf", nodes.Enroll).Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "enroll", node.ProvisionState)
+}
+
+func TestProvisionStateWaiterFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNodeGetDeployFailedSuccessfully(t)
+
+	_, err := nodes.NewProvisionStateWaiter(client.ServiceClient(), "1234asdf", nodes.Active).Wait(context.Background())
+	failure, ok := err.(gophercloud.ErrStateFailure[*nodes.Node])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "deploy failed", failure.State)
+	th.AssertEquals(t, "Timeout reached while waiting for callback", failure.Detail)
+}
@@ -0,0 +1,38 @@
+package nodes
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// NewProvisionStateWaiter returns a gophercloud.StateWaiter that waits for
+// the node to reach one of the target provision states. It fails as soon as
+// the node goes to one of the failed provision states, with the last error of
+// the node.
+func NewProvisionStateWaiter(c *gophercloud.ServiceClient, id string, target ...ProvisionState) *gophercloud.StateWaiter[*Node] {
+	states := make([]string, len(target))
+	for i, state := range target {
+		states[i] = string(state)
+	}
+
+	failure := []ProvisionState{DeployFail, CleanFail, Error, InspectFail, AdoptFail, RescueFail, UnrescueFail}
+	failureStates := make([]string, len(failure))
+	for i, state := range failure {
+		failureStates[i] = string(state)
+	}
+
+	return &gophercloud.StateWaiter[*Node]{
+		Get: func(ctx context.Context) (*Node, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(n *Node) string {
+			return n.ProvisionState
+		},
+		Detail: func(n *Node) string {
+			return n.LastError
+		},
+		Target:  states,
+		Failure: failureStates,
+	}
+}
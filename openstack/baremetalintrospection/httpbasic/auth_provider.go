@@ -0,0 +1,72 @@
+package httpbasic
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// authResult is the gophercloud.AuthResult of a "http_basic" bare metal
+// introspection service. It authenticates requests with the Authorization
+// header rather than with a token.
+type authResult struct {
+	authorization string
+}
+
+func (r authResult) ExtractTokenID() (string, error) {
+	return "", nil
+}
+
+func (r authResult) AuthHeaders() map[string]string {
+	return map[string]string{"Authorization": r.authorization}
+}
+
+// authProvider is a gophercloud.AuthProvider for "http_basic" bare metal
+// introspection services.
+type authProvider struct {
+	endpoint string
+	result   authResult
+}
+
+// NewAuthProvider returns a gophercloud.AuthProvider for a "http_basic" bare
+// metal introspection service. It authenticates requests with
+// eo.IronicInspectorUser and eo.IronicInspectorUserPassword and locates the
+// service at eo.IronicInspectorEndpoint, so that the ProviderClients it
+// authenticates may be used with openstack.NewBareMetalIntrospectionV1.
+func NewAuthProvider(eo EndpointOpts) (gophercloud.AuthProvider, error) {
+	if eo.IronicInspectorEndpoint == "" {
+		return nil, fmt.Errorf("IronicInspectorEndpoint is required")
+	}
+	if eo.IronicInspectorUser == "" || eo.IronicInspectorUserPassword == "" {
+		return nil, fmt.Errorf("User and Password are required")
+	}
+
+	token := []byte(eo.IronicInspectorUser + ":" + eo.IronicInspectorUserPassword)
+	return authProvider{
+		endpoint: gophercloud.NormalizeURL(eo.IronicInspectorEndpoint),
+		result:   authResult{authorization: "Basic " + base64.StdEncoding.EncodeToString(token)},
+	}, nil
+}
+
+func (p authProvider) Authenticate(client *gophercloud.ProviderClient) (gophercloud.AuthResult, gophercloud.EndpointLocator, error) {
+	locator := func(opts gophercloud.EndpointOpts) (string, error) {
+		if opts.Type != "baremetal-inspector" {
+			return "", &gophercloud.ErrEndpointNotFound{Types: []string{opts.Type}}
+		}
+		return p.endpoint, nil
+	}
+	return p.result, locator, nil
+}
+
+func (p authProvider) CanReauth() bool {
+	return false
+}
+
+func (p authProvider) Refresh(client *gophercloud.ProviderClient) (gophercloud.AuthResult, error) {
+	return p.result, nil
+}
+
+func (p authProvider) Revoke(client *gophercloud.ProviderClient, result gophercloud.AuthResult) error {
+	return nil
+}
@@ -13,5 +13,24 @@ Example of obtaining and using a client:
 	}
 
 	introspection.GetIntrospectionStatus(client, "a62b8495-52e2-407b-b3cb-62775d04c2b8")
+
+Example of authenticating a ProviderClient:
+
+	provider, err := httpbasic.NewAuthProvider(httpbasic.EndpointOpts{
+		IronicInspectorEndpoint:     "http://localhost:5050/v1/",
+		IronicInspectorUser:         "myUser",
+		IronicInspectorUserPassword: "myPassword",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	providerClient := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(providerClient, provider)
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := openstack.NewBareMetalIntrospectionV1(providerClient, gophercloud.EndpointOpts{})
 */
 package httpbasic
@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"testing"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetalintrospection/httpbasic"
 	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 )
@@ -32,3 +34,23 @@ func TestNoAuth(t *testing.T) {
 	_ = errTest2
 	th.AssertEquals(t, "IronicInspectorEndpoint is required", err.Error())
 }
+
+func TestHttpBasicProvider(t *testing.T) {
+	provider, err := httpbasic.NewAuthProvider(httpbasic.EndpointOpts{
+		IronicInspectorEndpoint:     "http://ironic:5050/v1",
+		IronicInspectorUser:         "myUser",
+		IronicInspectorUserPassword: "myPasswd",
+	})
+	th.AssertNoErr(t, err)
+
+	client := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+
+	encToken := base64.StdEncoding.EncodeToString([]byte("myUser:myPasswd"))
+	th.AssertDeepEquals(t, map[string]string{"Authorization": "Basic " + encToken}, client.AuthenticatedHeaders())
+
+	inspector, err := openstack.NewBareMetalIntrospectionV1(client, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http://ironic:5050/v1/", inspector.Endpoint)
+}
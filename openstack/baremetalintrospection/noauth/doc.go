@@ -11,5 +11,22 @@ Example of obtaining and using a client:
 	}
 
 	introspection.GetIntrospectionStatus(client, "a62b8495-52e2-407b-b3cb-62775d04c2b8")
+
+Example of authenticating a ProviderClient:
+
+	provider, err := noauth.NewAuthProvider(noauth.EndpointOpts{
+		IronicInspectorEndpoint: "http://localhost:5050/v1/",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	providerClient := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(providerClient, provider)
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := openstack.NewBareMetalIntrospectionV1(providerClient, gophercloud.EndpointOpts{})
 */
 package noauth
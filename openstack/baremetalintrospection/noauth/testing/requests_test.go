@@ -3,6 +3,8 @@ package testing
 import (
 	"testing"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/baremetalintrospection/noauth"
 	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 )
@@ -14,3 +16,19 @@ func TestNoAuth(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, "", noauthClient.TokenID)
 }
+
+func TestNoAuthProvider(t *testing.T) {
+	provider, err := noauth.NewAuthProvider(noauth.EndpointOpts{
+		IronicInspectorEndpoint: "http://ironic:5050/v1",
+	})
+	th.AssertNoErr(t, err)
+
+	client := new(gophercloud.ProviderClient)
+	err = openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(client.AuthenticatedHeaders()))
+
+	inspector, err := openstack.NewBareMetalIntrospectionV1(client, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http://ironic:5050/v1/", inspector.Endpoint)
+}
@@ -1,6 +1,8 @@
 package snapshots
 
 import (
+	"context"
+
 	"github.com/yogeshwargnanasekaran/gophercloud"
 )
 
@@ -20,3 +22,21 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 		return false, nil
 	})
 }
+
+// WaitForStatusContext will continually poll the resource, checking for a
+// particular status, until ctx is done. The Get requests are bound to ctx, so
+// cancelling it aborts an in-flight poll.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitForContext(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
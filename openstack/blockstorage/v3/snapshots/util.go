@@ -1,6 +1,8 @@
 package snapshots
 
 import (
+	"context"
+
 	"github.com/yogeshwargnanasekaran/gophercloud"
 )
 
@@ -20,3 +22,46 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 		return false, nil
 	})
 }
+
+// WaitForStatusContext will continually poll the resource, checking for a
+// particular status, until ctx is done. The Get requests are bound to ctx, so
+// cancelling it aborts an in-flight poll.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitForContext(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the
+// snapshot to reach one of the target statuses. It fails as soon as the
+// snapshot goes to one of the error statuses.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*Snapshot] {
+	return &gophercloud.StateWaiter[*Snapshot]{
+		Get: func(ctx context.Context) (*Snapshot, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(s *Snapshot) string {
+			return s.Status
+		},
+		Target:  target,
+		Failure: []string{"error", "error_deleting"},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the
+// snapshot to be deleted. It fails on error_deleting only.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Snapshot] {
+	w := NewStatusWaiter(c, id, "deleted")
+	w.Failure = []string{"error_deleting"}
+	w.NotFoundIsTarget = true
+	return w
+}
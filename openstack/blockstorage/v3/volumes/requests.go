@@ -171,6 +171,12 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// ListIterator returns an Iterator over the volumes returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(client *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Volume] {
+	return pagination.NewIterator(List(client, opts), ExtractVolumes)
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
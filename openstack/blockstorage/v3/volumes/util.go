@@ -1,6 +1,8 @@
 package volumes
 
 import (
+	"context"
+
 	"github.com/yogeshwargnanasekaran/gophercloud"
 )
 
@@ -20,3 +22,47 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 		return false, nil
 	})
 }
+
+// WaitForStatusContext will continually poll the resource, checking for a
+// particular status, until ctx is done. The Get requests are bound to ctx, so
+// cancelling it aborts an in-flight poll.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitForContext(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the volume
+// to reach one of the target statuses. It fails as soon as the volume goes to
+// one of the error statuses.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*Volume] {
+	return &gophercloud.StateWaiter[*Volume]{
+		Get: func(ctx context.Context) (*Volume, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(v *Volume) string {
+			return v.Status
+		},
+		Target:  target,
+		Failure: []string{"error", "error_deleting", "error_backing-up", "error_restoring", "error_extending"},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the volume
+// to be deleted. Volumes in error can be deleted, so it fails on error_deleting
+// only.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Volume] {
+	w := NewStatusWaiter(c, id, "deleted")
+	w.Failure = []string{"error_deleting"}
+	w.NotFoundIsTarget = true
+	return w
+}
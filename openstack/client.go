@@ -8,6 +8,7 @@ import (
 	"github.com/yogeshwargnanasekaran/gophercloud"
 	tokens2 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v2/tokens"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/ec2tokens"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/federatedauth"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/oauth1"
 	tokens3 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/utils"
@@ -88,32 +89,21 @@ func AuthenticatedClient(options gophercloud.AuthOptions) (*gophercloud.Provider
 }
 
 // Authenticate or re-authenticate against the most recent identity service
-// supported at the provided endpoint.
+// supported at the provided endpoint. It is equivalent to
+// AuthenticateWithProvider(client, NewAuthProvider(options)).
+//
+// If the client has a TokenCache, a token stored in it for the same endpoint,
+// user, credentials and scope is reused as long as it does not expire within
+// the client's TokenRefreshBefore, and new tokens are stored in it. If
+// TokenRefreshBefore is positive and options.AllowReauth is set, the token is
+// refreshed in the background before it expires.
 func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOptions) error {
-	versions := []*utils.Version{
-		{ID: v2, Priority: 20, Suffix: "/v2.0/"},
-		{ID: v3, Priority: 30, Suffix: "/v3/"},
-	}
-
-	chosen, endpoint, err := utils.ChooseVersion(client, versions)
-	if err != nil {
-		return err
-	}
-
-	switch chosen.ID {
-	case v2:
-		return v2auth(client, endpoint, options, gophercloud.EndpointOpts{})
-	case v3:
-		return v3auth(client, endpoint, &options, gophercloud.EndpointOpts{})
-	default:
-		// The switch statement must be out of date from the versions list.
-		return fmt.Errorf("Unrecognized identity version: %s", chosen.ID)
-	}
+	return AuthenticateWithProvider(client, NewAuthProvider(options))
 }
 
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v2auth(client, "", options, eo)
+	return AuthenticateWithProvider(client, NewAuthProviderV2(options, eo))
 }
 
 func v2auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
@@ -148,25 +138,6 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	if options.AllowReauth {
-		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
-		// with the token and reauth func zeroed out. combined with setting `AllowReauth` to `false`,
-		// this should retry authentication only once
-		tac := *client
-		tac.SetThrowaway(true)
-		tac.ReauthFunc = nil
-		tac.SetTokenAndAuthResult(nil)
-		tao := options
-		tao.AllowReauth = false
-		client.ReauthFunc = func() error {
-			err := v2auth(&tac, endpoint, tao, eo)
-			if err != nil {
-				return err
-			}
-			client.CopyTokenFrom(&tac)
-			return nil
-		}
-	}
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
 	}
@@ -176,7 +147,7 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 
 // AuthenticateV3 explicitly authenticates against the identity v3 service.
 func AuthenticateV3(client *gophercloud.ProviderClient, options tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
-	return v3auth(client, "", options, eo)
+	return AuthenticateWithProvider(client, NewAuthProviderV3(options, eo))
 }
 
 func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
@@ -227,11 +198,13 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		}
 	} else {
 		var result tokens3.CreateResult
-		switch opts.(type) {
+		switch o := opts.(type) {
 		case *ec2tokens.AuthOptions:
 			result = ec2tokens.Create(v3Client, opts)
 		case *oauth1.AuthOptions:
 			result = oauth1.Create(v3Client, opts)
+		case *federatedauth.AuthOptions:
+			result = federatedauth.Create(v3Client, o)
 		default:
 			result = tokens3.Create(v3Client, opts)
 		}
@@ -247,44 +220,6 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		}
 	}
 
-	if opts.CanReauth() {
-		// here we're creating a throw-away client (tac). it's a copy of the user's provider client, but
-		// with the token and reauth func zeroed out. combined with setting `AllowReauth` to `false`,
-		// this should retry authentication only once
-		tac := *client
-		tac.SetThrowaway(true)
-		tac.ReauthFunc = nil
-		tac.SetTokenAndAuthResult(nil)
-		var tao tokens3.AuthOptionsBuilder
-		switch ot := opts.(type) {
-		case *gophercloud.AuthOptions:
-			o := *ot
-			o.AllowReauth = false
-			tao = &o
-		case *tokens3.AuthOptions:
-			o := *ot
-			o.AllowReauth = false
-			tao = &o
-		case *ec2tokens.AuthOptions:
-			o := *ot
-			o.AllowReauth = false
-			tao = &o
-		case *oauth1.AuthOptions:
-			o := *ot
-			o.AllowReauth = false
-			tao = &o
-		default:
-			tao = opts
-		}
-		client.ReauthFunc = func() error {
-			err := v3auth(&tac, endpoint, tao, eo)
-			if err != nil {
-				return err
-			}
-			client.CopyTokenFrom(&tac)
-			return nil
-		}
-	}
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
 	}
@@ -300,7 +235,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	var err error
 	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
 		eo.ApplyDefaults(clientType)
-		endpoint, err = client.EndpointLocator(eo)
+		endpoint, err = locateEndpoint(client, eo)
 		if err != nil {
 			return nil, err
 		}
@@ -321,7 +256,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	var err error
 	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
 		eo.ApplyDefaults(clientType)
-		endpoint, err = client.EndpointLocator(eo)
+		endpoint, err = locateEndpoint(client, eo)
 		if err != nil {
 			return nil, err
 		}
@@ -350,7 +285,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 func initClientOpts(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts, clientType string) (*gophercloud.ServiceClient, error) {
 	sc := new(gophercloud.ServiceClient)
 	eo.ApplyDefaults(clientType)
-	url, err := client.EndpointLocator(eo)
+	url, err := locateEndpoint(client, eo)
 	if err != nil {
 		return sc, err
 	}
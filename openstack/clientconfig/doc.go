@@ -0,0 +1,62 @@
+/*
+Package clientconfig builds authentication options, endpoint options and an
+HTTP client from the standard OpenStack client configuration files:
+clouds.yaml, secure.yaml and clouds-public.yaml.
+
+The files are searched for, in order, in the current working directory, in
+$XDG_CONFIG_HOME/openstack (~/.config/openstack by default) and in
+/etc/openstack.
+
+The OS_CLIENT_CONFIG_FILE and OS_CLIENT_SECURE_FILE environment variables may
+point to a specific clouds.yaml and secure.yaml file, respectively.
+
+Settings are merged with the following precedence, from lowest to highest:
+the profile from clouds-public.yaml, the entry in clouds.yaml, the entry in
+secure.yaml, OS_* environment variables and finally the values set in
+ClientOpts.
+
+Example to Authenticate Using a Named Cloud
+
+	opts := &clientconfig.ClientOpts{
+		Cloud: "mycloud",
+	}
+
+	provider, err := clientconfig.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	eo, err := clientconfig.EndpointOpts(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	computeClient, err := openstack.NewComputeV2(provider, eo)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Use the Cloud Named by OS_CLOUD
+
+	ao, err := clientconfig.AuthOptions(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	httpClient, err := clientconfig.HTTPClient(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	provider, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		panic(err)
+	}
+	provider.HTTPClient = *httpClient
+
+	err = openstack.Authenticate(provider, *ao)
+	if err != nil {
+		panic(err)
+	}
+*/
+package clientconfig
@@ -0,0 +1,68 @@
+package clientconfig
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ErrCloudNotFound is the error when the requested cloud is not defined in
+// clouds.yaml.
+type ErrCloudNotFound struct {
+	gophercloud.BaseError
+	Cloud string
+}
+
+func (e ErrCloudNotFound) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Cloud %s was not found in clouds.yaml", e.Cloud)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrProfileNotFound is the error when the profile referenced by a cloud is
+// not defined in clouds-public.yaml.
+type ErrProfileNotFound struct {
+	gophercloud.BaseError
+	Cloud   string
+	Profile string
+}
+
+func (e ErrProfileNotFound) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Profile %s of cloud %s was not found in clouds-public.yaml", e.Profile, e.Cloud)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrUnsupportedAuthType is the error when the auth_type of a cloud is not
+// supported.
+type ErrUnsupportedAuthType struct {
+	gophercloud.BaseError
+	AuthType AuthType
+}
+
+func (e ErrUnsupportedAuthType) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Unsupported auth_type: %s", e.AuthType)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrInvalidInterface is the error when the interface of a cloud is not one
+// of public, internal or admin.
+type ErrInvalidInterface struct {
+	gophercloud.BaseError
+	Interface string
+}
+
+func (e ErrInvalidInterface) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Invalid interface: %s", e.Interface)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
@@ -0,0 +1,452 @@
+package clientconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
+)
+
+// ClientOpts represents options to customize the way a client is configured.
+type ClientOpts struct {
+	// Cloud is the name of the cloud entry to use. When empty, the value of
+	// the OS_CLOUD environment variable is used. When both are empty, the
+	// configuration is read from the environment only.
+	Cloud string
+
+	// EnvPrefix allows a custom environment variable prefix to be used.
+	// Defaults to "OS_".
+	EnvPrefix string
+
+	// AuthType overrides the auth_type of the cloud entry.
+	AuthType AuthType
+
+	// AuthInfo overrides the auth section of the cloud entry. Only non-empty
+	// fields are applied.
+	AuthInfo *AuthInfo
+
+	// RegionName overrides the region_name of the cloud entry.
+	RegionName string
+
+	// EndpointType overrides the interface of the cloud entry.
+	EndpointType string
+
+	// SearchPaths overrides the directories searched for clouds.yaml,
+	// secure.yaml and clouds-public.yaml.
+	SearchPaths []string
+}
+
+func (opts *ClientOpts) envPrefix() string {
+	if opts.EnvPrefix == "" {
+		return "OS_"
+	}
+	return opts.EnvPrefix
+}
+
+// LoadCloudsYAML reads the clouds.yaml file found in the search paths and
+// returns its cloud entries, keyed by name. A nil map is returned when no
+// file was found.
+func LoadCloudsYAML(searchPaths []string) (map[string]Cloud, error) {
+	return loadClouds("OS_CLIENT_CONFIG_FILE", "clouds", searchPaths)
+}
+
+// LoadSecureCloudsYAML reads the secure.yaml file found in the search paths
+// and returns its cloud entries, keyed by name. A nil map is returned when no
+// file was found.
+func LoadSecureCloudsYAML(searchPaths []string) (map[string]Cloud, error) {
+	return loadClouds("OS_CLIENT_SECURE_FILE", "secure", searchPaths)
+}
+
+// LoadPublicCloudsYAML reads the clouds-public.yaml file found in the search
+// paths and returns its profiles, keyed by name. A nil map is returned when no
+// file was found.
+func LoadPublicCloudsYAML(searchPaths []string) (map[string]Cloud, error) {
+	path, err := findConfigFile("OS_CLIENT_PUBLIC_FILE", "clouds-public", searchPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	var clouds PublicClouds
+	if err := loadYAML(path, &clouds); err != nil {
+		return nil, err
+	}
+	return clouds.Clouds, nil
+}
+
+func loadClouds(envVar, name string, searchPaths []string) (map[string]Cloud, error) {
+	path, err := findConfigFile(envVar, name, searchPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	var clouds Clouds
+	if err := loadYAML(path, &clouds); err != nil {
+		return nil, err
+	}
+	return clouds.Clouds, nil
+}
+
+// GetCloud returns the fully merged configuration of the cloud selected by
+// opts. See the package documentation for the order in which the sources are
+// merged.
+func GetCloud(opts *ClientOpts) (*Cloud, error) {
+	if opts == nil {
+		opts = new(ClientOpts)
+	}
+
+	searchPaths := opts.SearchPaths
+	if searchPaths == nil {
+		searchPaths = defaultSearchPaths()
+	}
+
+	prefix := opts.envPrefix()
+	cloudName := opts.Cloud
+	if cloudName == "" {
+		cloudName = os.Getenv(prefix + "CLOUD")
+	}
+
+	var cloud Cloud
+	if cloudName != "" {
+		clouds, err := LoadCloudsYAML(searchPaths)
+		if err != nil {
+			return nil, err
+		}
+
+		c, ok := clouds[cloudName]
+		if !ok {
+			return nil, ErrCloudNotFound{Cloud: cloudName}
+		}
+
+		profileName := c.Profile
+		if profileName == "" {
+			profileName = c.Cloud
+		}
+		if profileName != "" {
+			publicClouds, err := LoadPublicCloudsYAML(searchPaths)
+			if err != nil {
+				return nil, err
+			}
+			profile, ok := publicClouds[profileName]
+			if !ok {
+				return nil, ErrProfileNotFound{Cloud: cloudName, Profile: profileName}
+			}
+			c = mergeClouds(c, profile)
+		}
+
+		secureClouds, err := LoadSecureCloudsYAML(searchPaths)
+		if err != nil {
+			return nil, err
+		}
+		if secure, ok := secureClouds[cloudName]; ok {
+			c = mergeClouds(secure, c)
+		}
+
+		cloud = c
+	}
+
+	cloud = mergeClouds(envOverrides(prefix), cloud)
+	cloud = mergeClouds(Cloud{
+		AuthType:   opts.AuthType,
+		AuthInfo:   opts.AuthInfo,
+		RegionName: opts.RegionName,
+		Interface:  opts.EndpointType,
+	}, cloud)
+
+	if cloud.AuthInfo == nil {
+		cloud.AuthInfo = new(AuthInfo)
+	}
+
+	return &cloud, nil
+}
+
+// AuthOptions creates a gophercloud.AuthOptions structure with the settings
+// found in the cloud selected by opts. Use openstack.AuthenticatedClient, or
+// AuthenticatedClient in this package, to authenticate with it.
+func AuthOptions(opts *ClientOpts) (*gophercloud.AuthOptions, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+	return cloud.AuthOptions()
+}
+
+// AuthOptions creates a gophercloud.AuthOptions structure out of the cloud's
+// auth section.
+func (cloud Cloud) AuthOptions() (*gophercloud.AuthOptions, error) {
+	authInfo := AuthInfo{}
+	if cloud.AuthInfo != nil {
+		authInfo = *cloud.AuthInfo
+	}
+
+	if authInfo.AuthURL == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "auth_url"}
+	}
+
+	ao := &gophercloud.AuthOptions{
+		IdentityEndpoint: authInfo.AuthURL,
+	}
+
+	switch cloud.AuthType {
+	case AuthToken, AuthV2Token, AuthV3Token:
+		if authInfo.Token == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "token"}
+		}
+		ao.TokenID = authInfo.Token
+	case AuthV3ApplicationCredential:
+		if err := setApplicationCredential(ao, authInfo); err != nil {
+			return nil, err
+		}
+		return ao, nil
+	case "", AuthPassword, AuthV2Password, AuthV3Password:
+		// Guess the method from the provided credentials when auth_type is
+		// not set.
+		if cloud.AuthType == "" && authInfo.ApplicationCredentialSecret != "" {
+			if err := setApplicationCredential(ao, authInfo); err != nil {
+				return nil, err
+			}
+			return ao, nil
+		}
+		if cloud.AuthType == "" && authInfo.Token != "" && authInfo.Password == "" {
+			ao.TokenID = authInfo.Token
+			break
+		}
+
+		if authInfo.Username == "" && authInfo.UserID == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "username"}
+		}
+		if authInfo.Password == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "password"}
+		}
+
+		ao.Username = authInfo.Username
+		ao.UserID = authInfo.UserID
+		ao.Password = authInfo.Password
+		ao.AllowReauth = true
+
+		// The user's domain may not be given alongside a user ID.
+		if ao.UserID == "" && !isV2(cloud) {
+			ao.DomainID = firstNonEmpty(authInfo.UserDomainID, authInfo.DomainID)
+			ao.DomainName = firstNonEmpty(authInfo.UserDomainName, authInfo.DomainName)
+			if ao.DomainID == "" && ao.DomainName == "" {
+				ao.DomainID = authInfo.DefaultDomain
+			}
+			if ao.DomainID != "" {
+				ao.DomainName = ""
+			}
+		}
+	default:
+		return nil, ErrUnsupportedAuthType{AuthType: cloud.AuthType}
+	}
+
+	ao.TenantID = authInfo.ProjectID
+	ao.TenantName = authInfo.ProjectName
+
+	if !isV2(cloud) {
+		ao.Scope = scope(authInfo)
+	}
+
+	return ao, nil
+}
+
+func setApplicationCredential(ao *gophercloud.AuthOptions, authInfo AuthInfo) error {
+	if authInfo.ApplicationCredentialSecret == "" {
+		return gophercloud.ErrMissingInput{Argument: "application_credential_secret"}
+	}
+	if authInfo.ApplicationCredentialID == "" && authInfo.ApplicationCredentialName == "" {
+		return gophercloud.ErrMissingInput{Argument: "application_credential_id"}
+	}
+
+	ao.ApplicationCredentialID = authInfo.ApplicationCredentialID
+	ao.ApplicationCredentialName = authInfo.ApplicationCredentialName
+	ao.ApplicationCredentialSecret = authInfo.ApplicationCredentialSecret
+	ao.AllowReauth = true
+
+	// An application credential referenced by name needs its user.
+	if ao.ApplicationCredentialID == "" {
+		ao.Username = authInfo.Username
+		ao.UserID = authInfo.UserID
+		if ao.UserID == "" {
+			ao.DomainID = firstNonEmpty(authInfo.UserDomainID, authInfo.DomainID, authInfo.DefaultDomain)
+			if ao.DomainID == "" {
+				ao.DomainName = firstNonEmpty(authInfo.UserDomainName, authInfo.DomainName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// scope builds the v3 scope of the token out of the auth section.
+func scope(authInfo AuthInfo) *gophercloud.AuthScope {
+	switch {
+	case authInfo.SystemScope != "":
+		return &gophercloud.AuthScope{System: true}
+	case authInfo.ProjectID != "":
+		// A project ID may not be combined with a domain.
+		return &gophercloud.AuthScope{ProjectID: authInfo.ProjectID}
+	case authInfo.ProjectName != "":
+		s := &gophercloud.AuthScope{
+			ProjectName: authInfo.ProjectName,
+			DomainID:    firstNonEmpty(authInfo.ProjectDomainID, authInfo.DomainID),
+			DomainName:  firstNonEmpty(authInfo.ProjectDomainName, authInfo.DomainName),
+		}
+		if s.DomainID == "" && s.DomainName == "" {
+			s.DomainID = authInfo.DefaultDomain
+		}
+		if s.DomainID != "" {
+			s.DomainName = ""
+		}
+		return s
+	case authInfo.DomainID != "":
+		return &gophercloud.AuthScope{DomainID: authInfo.DomainID}
+	case authInfo.DomainName != "":
+		return &gophercloud.AuthScope{DomainName: authInfo.DomainName}
+	}
+	return nil
+}
+
+func isV2(cloud Cloud) bool {
+	switch cloud.AuthType {
+	case AuthV2Password, AuthV2Token:
+		return true
+	}
+	return strings.HasPrefix(cloud.IdentityAPIVersion, "2")
+}
+
+// EndpointOpts creates a gophercloud.EndpointOpts structure with the region
+// and interface found in the cloud selected by opts.
+func EndpointOpts(opts *ClientOpts) (gophercloud.EndpointOpts, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return gophercloud.EndpointOpts{}, err
+	}
+	return cloud.EndpointOpts()
+}
+
+// EndpointOpts creates a gophercloud.EndpointOpts structure out of the
+// cloud's region and interface.
+func (cloud Cloud) EndpointOpts() (gophercloud.EndpointOpts, error) {
+	eo := gophercloud.EndpointOpts{
+		Region: cloud.RegionName,
+	}
+
+	iface := strings.TrimSuffix(strings.ToLower(firstNonEmpty(cloud.Interface, cloud.EndpointType)), "url")
+	switch iface {
+	case "":
+	case "public":
+		eo.Availability = gophercloud.AvailabilityPublic
+	case "internal":
+		eo.Availability = gophercloud.AvailabilityInternal
+	case "admin":
+		eo.Availability = gophercloud.AvailabilityAdmin
+	default:
+		return eo, ErrInvalidInterface{Interface: iface}
+	}
+
+	return eo, nil
+}
+
+// HTTPClient creates an *http.Client honoring the verify, cacert, cert and
+// key settings found in the cloud selected by opts.
+func HTTPClient(opts *ClientOpts) (*http.Client, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+	return cloud.HTTPClient()
+}
+
+// HTTPClient creates an *http.Client honoring the cloud's verify, cacert,
+// cert and key settings.
+func (cloud Cloud) HTTPClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{}
+
+	if cloud.Verify != nil && !*cloud.Verify {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	if cloud.CACertFile != "" {
+		caCert, err := ioutil.ReadFile(cloud.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cacert %s: %s", cloud.CACertFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("unable to parse cacert %s", cloud.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cloud.ClientCertFile != "" || cloud.ClientKeyFile != "" {
+		if cloud.ClientCertFile == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "cert"}
+		}
+		if cloud.ClientKeyFile == "" {
+			return nil, gophercloud.ErrMissingInput{Argument: "key"}
+		}
+		cert, err := tls.LoadX509KeyPair(cloud.ClientCertFile, cloud.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// AuthenticatedClient returns a ProviderClient authenticated against the
+// cloud selected by opts, using an HTTP client configured by HTTPClient.
+func AuthenticatedClient(opts *ClientOpts) (*gophercloud.ProviderClient, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ao, err := cloud.AuthOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := cloud.HTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	pc.HTTPClient = *httpClient
+
+	switch {
+	case isV2(*cloud):
+		err = openstack.AuthenticateV2(pc, *ao, gophercloud.EndpointOpts{})
+	case strings.HasPrefix(cloud.IdentityAPIVersion, "3"):
+		err = openstack.AuthenticateV3(pc, ao, gophercloud.EndpointOpts{})
+	default:
+		err = openstack.Authenticate(pc, *ao)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
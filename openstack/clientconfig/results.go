@@ -0,0 +1,130 @@
+package clientconfig
+
+// Clouds represents the contents of a clouds.yaml or secure.yaml file.
+type Clouds struct {
+	Clouds map[string]Cloud `yaml:"clouds" json:"clouds"`
+}
+
+// PublicClouds represents the contents of a clouds-public.yaml file.
+type PublicClouds struct {
+	Clouds map[string]Cloud `yaml:"public-clouds" json:"public-clouds"`
+}
+
+// Cloud represents a single entry of a clouds.yaml file.
+type Cloud struct {
+	// Profile is the name of an entry of clouds-public.yaml to use as the
+	// base for this cloud.
+	Profile string `yaml:"profile,omitempty" json:"profile,omitempty"`
+
+	// Cloud is the deprecated name of Profile.
+	Cloud string `yaml:"cloud,omitempty" json:"cloud,omitempty"`
+
+	AuthInfo *AuthInfo `yaml:"auth,omitempty" json:"auth,omitempty"`
+	AuthType AuthType  `yaml:"auth_type,omitempty" json:"auth_type,omitempty"`
+
+	RegionName string `yaml:"region_name,omitempty" json:"region_name,omitempty"`
+
+	// EndpointType and Interface both select the endpoint interface
+	// ("public", "internal" or "admin"). Interface takes precedence.
+	EndpointType string `yaml:"endpoint_type,omitempty" json:"endpoint_type,omitempty"`
+	Interface    string `yaml:"interface,omitempty" json:"interface,omitempty"`
+
+	// IdentityAPIVersion selects the identity API version, "2.0" or "3".
+	// When empty, the version is discovered from the auth URL.
+	IdentityAPIVersion string `yaml:"identity_api_version,omitempty" json:"identity_api_version,omitempty"`
+
+	// Verify controls whether the server certificate is verified. It defaults
+	// to true.
+	Verify *bool `yaml:"verify,omitempty" json:"verify,omitempty"`
+
+	// CACertFile is the path to a CA bundle used to verify the server
+	// certificate.
+	CACertFile string `yaml:"cacert,omitempty" json:"cacert,omitempty"`
+
+	// ClientCertFile and ClientKeyFile are the paths to a client certificate
+	// and its private key.
+	ClientCertFile string `yaml:"cert,omitempty" json:"cert,omitempty"`
+	ClientKeyFile  string `yaml:"key,omitempty" json:"key,omitempty"`
+}
+
+// AuthInfo represents the auth section of a cloud entry.
+type AuthInfo struct {
+	AuthURL string `yaml:"auth_url,omitempty" json:"auth_url,omitempty"`
+	Token   string `yaml:"token,omitempty" json:"token,omitempty"`
+
+	Username string `yaml:"username,omitempty" json:"username,omitempty"`
+	UserID   string `yaml:"user_id,omitempty" json:"user_id,omitempty"`
+	Password string `yaml:"password,omitempty" json:"password,omitempty"`
+
+	ApplicationCredentialID     string `yaml:"application_credential_id,omitempty" json:"application_credential_id,omitempty"`
+	ApplicationCredentialName   string `yaml:"application_credential_name,omitempty" json:"application_credential_name,omitempty"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret,omitempty" json:"application_credential_secret,omitempty"`
+
+	// ProjectName and ProjectID are also accepted as tenant_name and
+	// tenant_id in older files, see UnmarshalYAML.
+	ProjectName string `yaml:"project_name,omitempty" json:"project_name,omitempty"`
+	ProjectID   string `yaml:"project_id,omitempty" json:"project_id,omitempty"`
+
+	UserDomainName    string `yaml:"user_domain_name,omitempty" json:"user_domain_name,omitempty"`
+	UserDomainID      string `yaml:"user_domain_id,omitempty" json:"user_domain_id,omitempty"`
+	ProjectDomainName string `yaml:"project_domain_name,omitempty" json:"project_domain_name,omitempty"`
+	ProjectDomainID   string `yaml:"project_domain_id,omitempty" json:"project_domain_id,omitempty"`
+
+	// DomainName and DomainID scope the token to a domain when no project is
+	// given. Otherwise they are used as the user and project domain when
+	// those are not set.
+	DomainName string `yaml:"domain_name,omitempty" json:"domain_name,omitempty"`
+	DomainID   string `yaml:"domain_id,omitempty" json:"domain_id,omitempty"`
+
+	// DefaultDomain is used as the user and project domain ID when no other
+	// domain information is available.
+	DefaultDomain string `yaml:"default_domain,omitempty" json:"default_domain,omitempty"`
+
+	// SystemScope requests a system-scoped token when set to "all".
+	SystemScope string `yaml:"system_scope,omitempty" json:"system_scope,omitempty"`
+}
+
+// UnmarshalYAML accepts the deprecated tenant_name and tenant_id keys as
+// aliases of project_name and project_id.
+func (r *AuthInfo) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type tmp AuthInfo
+	var s struct {
+		tmp        `yaml:",inline"`
+		TenantName string `yaml:"tenant_name,omitempty"`
+		TenantID   string `yaml:"tenant_id,omitempty"`
+	}
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	*r = AuthInfo(s.tmp)
+	if r.ProjectName == "" {
+		r.ProjectName = s.TenantName
+	}
+	if r.ProjectID == "" {
+		r.ProjectID = s.TenantID
+	}
+	return nil
+}
+
+// AuthType represents a valid method of authentication.
+type AuthType string
+
+const (
+	// AuthPassword defines an unknown version of the password.
+	AuthPassword AuthType = "password"
+	// AuthToken defines an unknown version of the token.
+	AuthToken AuthType = "token"
+
+	// AuthV2Password defines version 2 of the password.
+	AuthV2Password AuthType = "v2password"
+	// AuthV2Token defines version 2 of the token.
+	AuthV2Token AuthType = "v2token"
+
+	// AuthV3Password defines version 3 of the password.
+	AuthV3Password AuthType = "v3password"
+	// AuthV3Token defines version 3 of the token.
+	AuthV3Token AuthType = "v3token"
+
+	// AuthV3ApplicationCredential defines version 3 of the application credential.
+	AuthV3ApplicationCredential AuthType = "v3applicationcredential"
+)
@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+// CloudsYAML is a sample clouds.yaml file.
+const CloudsYAML = `
+clouds:
+  hawaii:
+    auth:
+      auth_url: "https://hi.example.com:5000/v3"
+      username: "jdoe"
+      project_name: "Some Project"
+      project_domain_name: "default"
+      user_domain_name: "default"
+    region_name: "HNL"
+    interface: "internal"
+    verify: false
+  florida:
+    profile: "sunshine"
+    auth:
+      username: "jdoe"
+      password: "password"
+      project_id: "12345"
+      user_domain_id: "abcde"
+  chicago:
+    auth:
+      auth_url: "https://chi.example.com:5000/v3"
+      application_credential_id: "app-cred-id"
+      application_credential_secret: "app-cred-secret"
+    auth_type: "v3applicationcredential"
+    region_name: "ORD"
+  virginia:
+    auth:
+      auth_url: "https://va.example.com:5000/v2.0"
+      username: "jdoe"
+      password: "password"
+      tenant_name: "Some Tenant"
+    identity_api_version: "2.0"
+`
+
+// SecureYAML is a sample secure.yaml file.
+const SecureYAML = `
+clouds:
+  hawaii:
+    auth:
+      password: "secret"
+`
+
+// PublicCloudsYAML is a sample clouds-public.yaml file.
+const PublicCloudsYAML = `
+public-clouds:
+  sunshine:
+    auth:
+      auth_url: "https://fl.example.com:5000/v3"
+    region_name: "MIA"
+    endpoint_type: "publicURL"
+`
+
+// WriteConfigFiles writes the sample configuration files to a temporary
+// directory, which is returned along with a function removing it.
+func WriteConfigFiles(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+
+	files := map[string]string{
+		"clouds.yaml":        CloudsYAML,
+		"secure.yaml":        SecureYAML,
+		"clouds-public.yaml": PublicCloudsYAML,
+	}
+	for name, content := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+		th.AssertNoErr(t, err)
+	}
+
+	return dir, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+// UnsetEnv clears the OS_* environment variables that influence the tests
+// and returns a function restoring them.
+func UnsetEnv() func() {
+	saved := map[string]string{}
+	for _, kv := range os.Environ() {
+		for i := 0; i < len(kv); i++ {
+			if kv[i] == '=' {
+				name := kv[:i]
+				if len(name) > 3 && name[:3] == "OS_" {
+					saved[name] = kv[i+1:]
+					os.Unsetenv(name)
+				}
+				break
+			}
+		}
+	}
+	return func() {
+		for k, v := range saved {
+			os.Setenv(k, v)
+		}
+	}
+}
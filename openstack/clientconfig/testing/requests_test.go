@@ -0,0 +1,180 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/clientconfig"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestGetCloudMergesSecureYAML(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{
+		Cloud:       "hawaii",
+		SearchPaths: []string{dir},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "jdoe", cloud.AuthInfo.Username)
+	th.AssertEquals(t, "secret", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "HNL", cloud.RegionName)
+	th.AssertEquals(t, false, *cloud.Verify)
+}
+
+func TestAuthOptionsFromProfile(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	opts := &clientconfig.ClientOpts{
+		Cloud:       "florida",
+		SearchPaths: []string{dir},
+	}
+
+	ao, err := clientconfig.AuthOptions(opts)
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://fl.example.com:5000/v3",
+		Username:         "jdoe",
+		Password:         "password",
+		DomainID:         "abcde",
+		TenantID:         "12345",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			ProjectID: "12345",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+
+	eo, err := clientconfig.EndpointOpts(opts)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "MIA",
+		Availability: gophercloud.AvailabilityPublic,
+	}, eo)
+}
+
+func TestAuthOptionsEnvOverrides(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	os.Setenv("OS_CLOUD", "hawaii")
+	os.Setenv("OS_REGION_NAME", "OGG")
+
+	opts := &clientconfig.ClientOpts{
+		SearchPaths: []string{dir},
+	}
+
+	ao, err := clientconfig.AuthOptions(opts)
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://hi.example.com:5000/v3",
+		Username:         "jdoe",
+		Password:         "secret",
+		DomainName:       "default",
+		TenantName:       "Some Project",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			ProjectName: "Some Project",
+			DomainName:  "default",
+		},
+	}
+	th.AssertDeepEquals(t, expected, ao)
+
+	eo, err := clientconfig.EndpointOpts(opts)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "OGG",
+		Availability: gophercloud.AvailabilityInternal,
+	}, eo)
+
+	httpClient, err := clientconfig.HTTPClient(opts)
+	th.AssertNoErr(t, err)
+	if httpClient.Transport == nil {
+		t.Fatal("expected a transport to be configured")
+	}
+}
+
+func TestAuthOptionsApplicationCredential(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{
+		Cloud:       "chicago",
+		SearchPaths: []string{dir},
+	})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://chi.example.com:5000/v3",
+		ApplicationCredentialID:     "app-cred-id",
+		ApplicationCredentialSecret: "app-cred-secret",
+		AllowReauth:                 true,
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestAuthOptionsV2(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{
+		Cloud:       "virginia",
+		SearchPaths: []string{dir},
+	})
+	th.AssertNoErr(t, err)
+
+	expected := &gophercloud.AuthOptions{
+		IdentityEndpoint: "https://va.example.com:5000/v2.0",
+		Username:         "jdoe",
+		Password:         "password",
+		TenantName:       "Some Tenant",
+		AllowReauth:      true,
+	}
+	th.AssertDeepEquals(t, expected, ao)
+}
+
+func TestClientOptsOverrides(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{
+		Cloud:       "hawaii",
+		SearchPaths: []string{dir},
+		RegionName:  "KOA",
+		AuthInfo: &clientconfig.AuthInfo{
+			Password: "override",
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "KOA", cloud.RegionName)
+	th.AssertEquals(t, "override", cloud.AuthInfo.Password)
+	th.AssertEquals(t, "jdoe", cloud.AuthInfo.Username)
+}
+
+func TestCloudNotFound(t *testing.T) {
+	defer UnsetEnv()()
+	dir, cleanup := WriteConfigFiles(t)
+	defer cleanup()
+
+	_, err := clientconfig.GetCloud(&clientconfig.ClientOpts{
+		Cloud:       "alaska",
+		SearchPaths: []string{dir},
+	})
+	th.AssertEquals(t, clientconfig.ErrCloudNotFound{Cloud: "alaska"}, err)
+	th.AssertEquals(t, "Cloud alaska was not found in clouds.yaml", err.Error())
+
+	notFound := clientconfig.ErrCloudNotFound{Cloud: "alaska"}
+	notFound.Info = "custom message"
+	th.AssertEquals(t, "custom message", notFound.Error())
+}
@@ -0,0 +1,178 @@
+package clientconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// defaultSearchPaths returns the directories searched for configuration
+// files, in order of precedence.
+func defaultSearchPaths() []string {
+	var paths []string
+
+	if cwd, err := os.Getwd(); err == nil {
+		paths = append(paths, cwd)
+	}
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			configHome = filepath.Join(home, ".config")
+		}
+	}
+	if configHome != "" {
+		paths = append(paths, filepath.Join(configHome, "openstack"))
+	}
+
+	return append(paths, "/etc/openstack")
+}
+
+// findConfigFile returns the path of the first file named name, with either a
+// .yaml or .yml extension, found in the search paths. If envVar is set, its
+// value is used instead of searching. An empty path is returned when no file
+// was found.
+func findConfigFile(envVar, name string, searchPaths []string) (string, error) {
+	if v := os.Getenv(envVar); v != "" {
+		if _, err := os.Stat(v); err != nil {
+			return "", err
+		}
+		return v, nil
+	}
+
+	for _, dir := range searchPaths {
+		for _, ext := range []string{".yaml", ".yml"} {
+			p := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(p); err == nil {
+				return p, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// loadYAML reads the file at path into to. A missing path is not an error.
+func loadYAML(path string, to interface{}) error {
+	if path == "" {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return yaml.Unmarshal(content, to)
+}
+
+// mergeClouds returns a copy of base with every non-zero field of override
+// applied on top of it. Nested structs are merged field by field.
+func mergeClouds(override, base Cloud) Cloud {
+	merged := base
+	if base.AuthInfo != nil {
+		authInfo := *base.AuthInfo
+		merged.AuthInfo = &authInfo
+	}
+	mergeValues(reflect.ValueOf(&merged).Elem(), reflect.ValueOf(override))
+	return merged
+}
+
+func mergeValues(dst, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		s := src.Field(i)
+		d := dst.Field(i)
+
+		switch s.Kind() {
+		case reflect.Ptr:
+			if s.IsNil() {
+				continue
+			}
+			if s.Elem().Kind() == reflect.Struct && !d.IsNil() {
+				mergeValues(d.Elem(), s.Elem())
+				continue
+			}
+			v := reflect.New(s.Elem().Type())
+			v.Elem().Set(s.Elem())
+			d.Set(v)
+		case reflect.Struct:
+			mergeValues(d, s)
+		default:
+			if !isZero(s) {
+				d.Set(s)
+			}
+		}
+	}
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// envOverrides builds a Cloud out of the environment variables starting with
+// prefix.
+func envOverrides(prefix string) Cloud {
+	get := func(names ...string) string {
+		for _, name := range names {
+			if v := os.Getenv(prefix + name); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	authInfo := AuthInfo{
+		AuthURL:                     get("AUTH_URL"),
+		Token:                       get("TOKEN", "AUTH_TOKEN"),
+		Username:                    get("USERNAME"),
+		UserID:                      get("USER_ID", "USERID"),
+		Password:                    get("PASSWORD"),
+		ApplicationCredentialID:     get("APPLICATION_CREDENTIAL_ID"),
+		ApplicationCredentialName:   get("APPLICATION_CREDENTIAL_NAME"),
+		ApplicationCredentialSecret: get("APPLICATION_CREDENTIAL_SECRET"),
+		ProjectName:                 get("PROJECT_NAME", "TENANT_NAME"),
+		ProjectID:                   get("PROJECT_ID", "TENANT_ID"),
+		UserDomainName:              get("USER_DOMAIN_NAME"),
+		UserDomainID:                get("USER_DOMAIN_ID"),
+		ProjectDomainName:           get("PROJECT_DOMAIN_NAME"),
+		ProjectDomainID:             get("PROJECT_DOMAIN_ID"),
+		DomainName:                  get("DOMAIN_NAME"),
+		DomainID:                    get("DOMAIN_ID"),
+		DefaultDomain:               get("DEFAULT_DOMAIN"),
+		SystemScope:                 get("SYSTEM_SCOPE"),
+	}
+
+	cloud := Cloud{
+		AuthType:           AuthType(get("AUTH_TYPE")),
+		RegionName:         get("REGION_NAME"),
+		EndpointType:       get("ENDPOINT_TYPE"),
+		Interface:          get("INTERFACE"),
+		IdentityAPIVersion: get("IDENTITY_API_VERSION"),
+		CACertFile:         get("CACERT"),
+		ClientCertFile:     get("CERT"),
+		ClientKeyFile:      get("KEY"),
+	}
+
+	if authInfo != (AuthInfo{}) {
+		cloud.AuthInfo = &authInfo
+	}
+
+	switch strings.ToLower(get("INSECURE")) {
+	case "true", "1", "yes":
+		verify := false
+		cloud.Verify = &verify
+	case "false", "0", "no":
+		verify := true
+		cloud.Verify = &verify
+	}
+
+	return cloud
+}
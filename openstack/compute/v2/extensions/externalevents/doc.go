@@ -0,0 +1,49 @@
+/*
+Package externalevents provides the ability to submit server external events
+to the OpenStack Compute service, as other services do to notify it of changes
+to the resources of its servers. This requires admin privileges.
+
+Example to Notify a Server that a Port was Plugged
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{
+			{
+				Name:       externalevents.NetworkVIFPlugged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Status:     externalevents.EventStatusCompleted,
+				Tag:        "0b8bc7ab-e2cc-41d2-9e32-c1dcbd0e2fa5",
+			},
+		},
+	}
+
+	events, err := externalevents.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, event := range events {
+		if event.Code != 200 {
+			fmt.Printf("event %s of server %s was rejected: %d\n", event.Name, event.ServerUUID, event.Code)
+		}
+	}
+
+Example to Notify a Server that a Volume was Extended
+
+	computeClient.Microversion = "2.51"
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{
+			{
+				Name:       externalevents.VolumeExtended,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "a3e9a9a9-3b9e-4c0e-9c0f-7c2ba6d8f1e4",
+			},
+		},
+	}
+
+	_, err := externalevents.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package externalevents
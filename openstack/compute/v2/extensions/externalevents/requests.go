@@ -0,0 +1,103 @@
+package externalevents
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// EventName is the name of an external event.
+type EventName string
+
+const (
+	// NetworkChanged notifies a server that its network information changed.
+	NetworkChanged EventName = "network-changed"
+
+	// NetworkVIFPlugged notifies a server that one of its ports was plugged.
+	NetworkVIFPlugged EventName = "network-vif-plugged"
+
+	// NetworkVIFUnplugged notifies a server that one of its ports was
+	// unplugged.
+	NetworkVIFUnplugged EventName = "network-vif-unplugged"
+
+	// NetworkVIFDeleted notifies a server that one of its ports was deleted.
+	NetworkVIFDeleted EventName = "network-vif-deleted"
+
+	// VolumeExtended notifies a server that one of its volumes was extended.
+	// This requires microversion 2.51 or later.
+	VolumeExtended EventName = "volume-extended"
+
+	// PowerUpdate notifies a server that its power state changed.
+	// This requires microversion 2.76 or later.
+	PowerUpdate EventName = "power-update"
+
+	// AcceleratorRequestBound notifies a server that one of its accelerator
+	// requests was bound.
+	// This requires microversion 2.82 or later.
+	AcceleratorRequestBound EventName = "accelerator-request-bound"
+
+	// VolumeReimaged notifies a server that its root volume was reimaged.
+	// This requires microversion 2.93 or later.
+	VolumeReimaged EventName = "volume-reimaged"
+)
+
+// EventStatus is the status of an external event.
+type EventStatus string
+
+const (
+	EventStatusCompleted  EventStatus = "completed"
+	EventStatusFailed     EventStatus = "failed"
+	EventStatusInProgress EventStatus = "in-progress"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToExternalEventsCreateMap() (map[string]interface{}, error)
+}
+
+// EventOpts specifies an external event.
+type EventOpts struct {
+	// Name is the name of the event.
+	Name EventName `json:"name" required:"true"`
+
+	// ServerUUID is the UUID of the server the event is for.
+	ServerUUID string `json:"server_uuid" required:"true"`
+
+	// Status is the status of the event. It defaults to completed.
+	Status EventStatus `json:"status,omitempty"`
+
+	// Tag identifies the resource the event is about, such as the ID of the
+	// port for the network-vif-* events, or the ID of the volume for the
+	// volume-extended event. For the power-update event, it is either
+	// "POWER_ON" or "POWER_OFF".
+	Tag string `json:"tag,omitempty"`
+}
+
+// CreateOpts specifies the external events to submit.
+type CreateOpts struct {
+	// Events are the events to submit.
+	Events []EventOpts `json:"events" required:"true"`
+}
+
+// ToExternalEventsCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToExternalEventsCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create submits external events to the Compute service. This is typically
+// done by other services, such as the Networking service, and requires admin
+// privileges.
+//
+// The request succeeds if at least one event was accepted. If only some of
+// them were, the Code of the Events extracted from the result tells which.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToExternalEventsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 207},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,41 @@
+package externalevents
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// Event is an external event, as accepted or rejected by the Compute service.
+type Event struct {
+	// Name is the name of the event.
+	Name EventName `json:"name"`
+
+	// ServerUUID is the UUID of the server the event is for.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the event.
+	Status EventStatus `json:"status"`
+
+	// Tag identifies the resource the event is about.
+	Tag string `json:"tag"`
+
+	// Code is the HTTP status of the event: 200 if it was accepted, 400 if
+	// it was invalid, 404 if its server was not found, and 422 if its server
+	// is not assigned to a host.
+	Code int `json:"code"`
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a slice of Events.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a slice of Events, in the order they
+// were submitted.
+func (r CreateResult) Extract() ([]Event, error) {
+	var s struct {
+		Events []Event `json:"events"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Events, err
+}
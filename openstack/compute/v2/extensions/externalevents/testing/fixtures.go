@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/externalevents"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// CreateRequest is the expected request body of a Create call.
+const CreateRequest = `
+{
+    "events": [
+        {
+            "name": "network-vif-plugged",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "status": "completed",
+            "tag": "0b8bc7ab-e2cc-41d2-9e32-c1dcbd0e2fa5"
+        },
+        {
+            "name": "volume-extended",
+            "server_uuid": "9a5c0a4b-1e1d-4e5b-a2e5-0d0c3b6a9e5f",
+            "tag": "a3e9a9a9-3b9e-4c0e-9c0f-7c2ba6d8f1e4"
+        }
+    ]
+}
+`
+
+// CreateResponse is a sample response to a Create call, where one of the
+// events was rejected.
+const CreateResponse = `
+{
+    "events": [
+        {
+            "code": 200,
+            "name": "network-vif-plugged",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "status": "completed",
+            "tag": "0b8bc7ab-e2cc-41d2-9e32-c1dcbd0e2fa5"
+        },
+        {
+            "code": 404,
+            "name": "volume-extended",
+            "server_uuid": "9a5c0a4b-1e1d-4e5b-a2e5-0d0c3b6a9e5f",
+            "status": "failed",
+            "tag": "a3e9a9a9-3b9e-4c0e-9c0f-7c2ba6d8f1e4"
+        }
+    ]
+}
+`
+
+// ExpectedEvents are the Events of CreateResponse.
+var ExpectedEvents = []externalevents.Event{
+	{
+		Name:       externalevents.NetworkVIFPlugged,
+		ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+		Status:     externalevents.EventStatusCompleted,
+		Tag:        "0b8bc7ab-e2cc-41d2-9e32-c1dcbd0e2fa5",
+		Code:       200,
+	},
+	{
+		Name:       externalevents.VolumeExtended,
+		ServerUUID: "9a5c0a4b-1e1d-4e5b-a2e5-0d0c3b6a9e5f",
+		Status:     externalevents.EventStatusFailed,
+		Tag:        "a3e9a9a9-3b9e-4c0e-9c0f-7c2ba6d8f1e4",
+		Code:       404,
+	},
+}
+
+// HandleCreatePartiallySuccessfully configures the test server to respond to
+// a Create request with a Multi-Status response.
+func HandleCreatePartiallySuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
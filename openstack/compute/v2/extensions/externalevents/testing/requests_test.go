@@ -0,0 +1,48 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/externalevents"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreatePartiallySuccessfully(t)
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{
+			{
+				Name:       externalevents.NetworkVIFPlugged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Status:     externalevents.EventStatusCompleted,
+				Tag:        "0b8bc7ab-e2cc-41d2-9e32-c1dcbd0e2fa5",
+			},
+			{
+				Name:       externalevents.VolumeExtended,
+				ServerUUID: "9a5c0a4b-1e1d-4e5b-a2e5-0d0c3b6a9e5f",
+				Tag:        "a3e9a9a9-3b9e-4c0e-9c0f-7c2ba6d8f1e4",
+			},
+		},
+	}
+
+	actual, err := externalevents.Create(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEvents, actual)
+}
+
+func TestCreateRequiresServerUUID(t *testing.T) {
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{
+			{Name: externalevents.NetworkChanged},
+		},
+	}
+
+	_, err := createOpts.ToExternalEventsCreateMap()
+	if err == nil {
+		t.Fatal("Expected an error for the missing server UUID")
+	}
+}
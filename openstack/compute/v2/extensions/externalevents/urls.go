@@ -0,0 +1,7 @@
+package externalevents
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+func createURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("os-server-external-events")
+}
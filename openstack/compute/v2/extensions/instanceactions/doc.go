@@ -23,4 +23,39 @@ Example to List and Get actions:
 
 		fmt.Println(action)
 	}
+
+Example to List the Actions of the Last Day and their Failed Events
+
+	client.Microversion = "2.84"
+
+	since := time.Now().Add(-24 * time.Hour)
+	listOpts := instanceactions.ListOpts{
+		Limit:        50,
+		ChangesSince: &since,
+	}
+
+	err := instanceactions.List(client, "server-id", listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		actions, err := instanceactions.ExtractInstanceActions(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, action := range actions {
+			detail, err := instanceactions.Get(client, "server-id", action.RequestID).Extract()
+			if err != nil {
+				return false, err
+			}
+
+			for _, event := range *detail.Events {
+				if event.Result == "Error" && event.Details != nil {
+					fmt.Printf("%s: %s\n", event.Event, *event.Details)
+				}
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		panic(err)
+	}
 */
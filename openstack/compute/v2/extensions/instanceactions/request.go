@@ -55,7 +55,9 @@ func (opts ListOpts) ToInstanceActionsListQuery() (string, error) {
 	return q.String(), nil
 }
 
-// List makes a request against the API to list the servers actions.
+// List makes a request against the API to list the servers actions. From
+// microversion 2.58, the actions are returned by pages of at most Limit
+// actions, most recent first.
 func List(client *gophercloud.ServiceClient, id string, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(client, id)
 	if opts != nil {
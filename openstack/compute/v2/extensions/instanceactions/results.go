@@ -30,6 +30,10 @@ type InstanceAction struct {
 
 	// UserID is the ID of the user which initiated the action.
 	UserID string `json:"user_id"`
+
+	// UpdatedAt is the time the action was last updated.
+	// This requires microversion 2.58 or later.
+	UpdatedAt *time.Time `json:"-"`
 }
 
 // UnmarshalJSON converts our JSON API response into our instance action struct
@@ -37,7 +41,8 @@ func (i *InstanceAction) UnmarshalJSON(b []byte) error {
 	type tmp InstanceAction
 	var s struct {
 		tmp
-		StartTime gophercloud.JSONRFC3339MilliNoZ `json:"start_time"`
+		StartTime gophercloud.JSONRFC3339MilliNoZ  `json:"start_time"`
+		UpdatedAt *gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
 	}
 	err := json.Unmarshal(b, &s)
 	if err != nil {
@@ -46,6 +51,7 @@ func (i *InstanceAction) UnmarshalJSON(b []byte) error {
 	*i = InstanceAction(s.tmp)
 
 	i.StartTime = time.Time(s.StartTime)
+	i.UpdatedAt = (*time.Time)(s.UpdatedAt)
 
 	return err
 }
@@ -64,6 +70,20 @@ func (r InstanceActionPage) IsEmpty() (bool, error) {
 	return len(instanceactions) == 0, err
 }
 
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Instance actions are paginated from microversion 2.58;
+// before, the only page has no links.
+func (r InstanceActionPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
 // ExtractInstanceActions interprets a page of results as a slice
 // of InstanceAction.
 func ExtractInstanceActions(r pagination.Page) ([]InstanceAction, error) {
@@ -88,13 +108,20 @@ type Event struct {
 	// Result is the result of the event.
 	Result string `json:"result"`
 
-	// Traceback is the traceback stack if an error occurred.
+	// Traceback is the traceback stack if an error occurred. It is only
+	// returned to administrators.
 	Traceback string `json:"traceback"`
 
+	// Details is the description of the error of a failed event, which,
+	// unlike Traceback, is returned to all users.
+	// This requires microversion 2.84 or later.
+	Details *string `json:"details"`
+
 	// StartTime is the time the action started.
 	StartTime time.Time `json:"-"`
 
-	// FinishTime is the time the event finished.
+	// FinishTime is the time the event finished. It is zero while the event
+	// is in progress.
 	FinishTime time.Time `json:"-"`
 }
 
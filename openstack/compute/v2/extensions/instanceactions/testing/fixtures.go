@@ -126,3 +126,149 @@ func HandleInstanceActionGetSuccessfully(t *testing.T) {
 		}`)
 	})
 }
+
+var (
+	expectedPagedUpdatedAt = time.Date(2018, 04, 25, 1, 26, 36, 0, time.UTC)
+
+	// ListPagedExpected represents the expected actions of both pages of a
+	// List request with a limit.
+	ListPagedExpected = []instanceactions.InstanceAction{
+		{
+			Action:       "stop",
+			InstanceUUID: "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+			ProjectID:    "6f70656e737461636b20342065766572",
+			RequestID:    "req-f8a59f03-76dc-412f-92c2-21f8612be728",
+			StartTime:    time.Date(2018, 04, 25, 1, 26, 29, 0, time.UTC),
+			UpdatedAt:    &expectedPagedUpdatedAt,
+			UserID:       "admin",
+		},
+		{
+			Action:       "create",
+			InstanceUUID: "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+			ProjectID:    "6f70656e737461636b20342065766572",
+			RequestID:    "req-50189019-626d-47fb-b944-b8342af09679",
+			StartTime:    time.Date(2018, 04, 25, 1, 26, 25, 0, time.UTC),
+			UpdatedAt:    &expectedPagedUpdatedAt,
+			UserID:       "admin",
+		},
+	}
+)
+
+// HandleInstanceActionListPagedSuccessfully sets up the test server to respond
+// to a List request with a limit and a time range, paginated as from
+// microversion 2.58.
+func HandleInstanceActionListPagedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/asdfasdfasdf/os-instance-actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"limit":          "1",
+				"changes-since":  "2018-04-25T00:00:00Z",
+				"changes-before": "2018-04-26T00:00:00Z",
+			})
+			fmt.Fprintf(w, `{
+				"instanceActions": [
+					{
+						"action": "stop",
+						"instance_uuid": "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+						"message": null,
+						"project_id": "6f70656e737461636b20342065766572",
+						"request_id": "req-f8a59f03-76dc-412f-92c2-21f8612be728",
+						"start_time": "2018-04-25T01:26:29.000000",
+						"updated_at": "2018-04-25T01:26:36.000000",
+						"user_id": "admin"
+					}
+				],
+				"links": [
+					{
+						"href": "%s/servers/asdfasdfasdf/os-instance-actions?limit=1&marker=req-f8a59f03-76dc-412f-92c2-21f8612be728",
+						"rel": "next"
+					}
+				]
+			}`, th.Server.URL)
+		case "req-f8a59f03-76dc-412f-92c2-21f8612be728":
+			fmt.Fprint(w, `{
+				"instanceActions": [
+					{
+						"action": "create",
+						"instance_uuid": "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+						"message": null,
+						"project_id": "6f70656e737461636b20342065766572",
+						"request_id": "req-50189019-626d-47fb-b944-b8342af09679",
+						"start_time": "2018-04-25T01:26:25.000000",
+						"updated_at": "2018-04-25T01:26:36.000000",
+						"user_id": "admin"
+					}
+				]
+			}`)
+		default:
+			t.Errorf("Unexpected marker %s", r.URL.Query().Get("marker"))
+		}
+	})
+}
+
+var (
+	expectedFailedEventDetails = "No valid host was found. There are not enough hosts available."
+	expectedFailedEvents       = []instanceactions.Event{{
+		Event:     "conductor_schedule_and_build_instances",
+		Host:      &expectedEventHost,
+		HostID:    &expectedEventHostID,
+		Result:    "Error",
+		Traceback: "Traceback (most recent call last):\n  File \"nova/conductor/manager.py\", line 1501\nNoValidHost: No valid host was found.",
+		Details:   &expectedFailedEventDetails,
+		StartTime: time.Date(2018, 04, 25, 1, 26, 25, 0, time.UTC),
+	}}
+
+	// GetFailedExpected represents an expected response from a Get request
+	// for a failed action, as from microversion 2.84.
+	GetFailedExpected = instanceactions.InstanceActionDetail{
+		Action:       "create",
+		InstanceUUID: "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+		Message:      "Error",
+		ProjectID:    "6f70656e737461636b20342065766572",
+		RequestID:    "req-50189019-626d-47fb-b944-b8342af09679",
+		StartTime:    time.Date(2018, 04, 25, 1, 26, 25, 0, time.UTC),
+		UpdatedAt:    &expectedPagedUpdatedAt,
+		UserID:       "admin",
+		Events:       &expectedFailedEvents,
+	}
+)
+
+// HandleInstanceActionGetFailedSuccessfully sets up the test server to respond
+// to a Get request for a failed action, whose event has not finished.
+func HandleInstanceActionGetFailedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/asdfasdfasdf/os-instance-actions/req-50189019-626d-47fb-b944-b8342af09679", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"instanceAction": {
+				"action": "create",
+				"events": [
+					{
+						"event": "conductor_schedule_and_build_instances",
+						"finish_time": null,
+						"host": "compute",
+						"hostId": "2091634baaccdc4c5a1d57069c833e402921df696b7f970791b12ec6",
+						"result": "Error",
+						"start_time": "2018-04-25T01:26:25.000000",
+						"traceback": "Traceback (most recent call last):\n  File \"nova/conductor/manager.py\", line 1501\nNoValidHost: No valid host was found.",
+						"details": "No valid host was found. There are not enough hosts available."
+					}
+				],
+				"instance_uuid": "fcd19ef2-b593-40b1-90a5-fc31063fa95c",
+				"message": "Error",
+				"project_id": "6f70656e737461636b20342065766572",
+				"request_id": "req-50189019-626d-47fb-b944-b8342af09679",
+				"start_time": "2018-04-25T01:26:25.000000",
+				"updated_at": "2018-04-25T01:26:36.000000",
+				"user_id": "admin"
+			}
+		}`)
+	})
+}
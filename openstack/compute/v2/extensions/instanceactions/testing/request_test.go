@@ -2,6 +2,7 @@ package testing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/instanceactions"
 	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
@@ -46,3 +47,60 @@ func TestGet(t *testing.T) {
 
 	th.CheckDeepEquals(t, GetExpected, actual)
 }
+
+func TestListPaged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionListPagedSuccessfully(t)
+
+	since := time.Date(2018, 04, 25, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2018, 04, 26, 0, 0, 0, 0, time.UTC)
+	opts := instanceactions.ListOpts{
+		Limit:         1,
+		ChangesSince:  &since,
+		ChangesBefore: &before,
+	}
+
+	pages := 0
+	err := instanceactions.List(client.ServiceClient(), "asdfasdfasdf", opts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := instanceactions.ExtractInstanceActions(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ListPagedExpected[pages-1:pages], actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, pages)
+}
+
+func TestListPagedAllPages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionListPagedSuccessfully(t)
+
+	since := time.Date(2018, 04, 25, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2018, 04, 26, 0, 0, 0, 0, time.UTC)
+	opts := instanceactions.ListOpts{
+		Limit:         1,
+		ChangesSince:  &since,
+		ChangesBefore: &before,
+	}
+
+	allPages, err := instanceactions.List(client.ServiceClient(), "asdfasdfasdf", opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := instanceactions.ExtractInstanceActions(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ListPagedExpected, actual)
+}
+
+func TestGetFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInstanceActionGetFailedSuccessfully(t)
+
+	actual, err := instanceactions.Get(client.ServiceClient(), "asdfasdfasdf", "req-50189019-626d-47fb-b944-b8342af09679").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GetFailedExpected, actual)
+}
@@ -0,0 +1,62 @@
+/*
+Package servermigrations provides the ability to list, show, force the
+completion of and abort the in-progress live migrations of a server, and to
+list the migrations of all servers.
+
+Example to List the In-Progress Live Migrations of a Server
+
+	computeClient.Microversion = "2.23"
+
+	allPages, err := servermigrations.List(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := servermigrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%d: %d bytes of memory remaining\n", migration.ID, migration.MemoryRemainingBytes)
+	}
+
+Example to Force the Completion of a Live Migration
+
+	computeClient.Microversion = "2.22"
+
+	err := servermigrations.ForceComplete(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	computeClient.Microversion = "2.24"
+
+	err := servermigrations.Abort(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Live Migrations Running on a Host
+
+	computeClient.Microversion = "2.59"
+
+	listOpts := servermigrations.ListAllOpts{
+		Host:          "compute-01",
+		MigrationType: servermigrations.MigrationTypeLiveMigration,
+		Status:        "running",
+	}
+
+	allPages, err := servermigrations.ListAll(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := servermigrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+*/
+package servermigrations
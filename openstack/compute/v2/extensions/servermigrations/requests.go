@@ -0,0 +1,150 @@
+package servermigrations
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// List lists the in-progress live migrations of a server.
+// This requires microversion 2.23 or later.
+func List(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return ServerMigrationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves an in-progress live migration of a server.
+// This requires microversion 2.23 or later.
+func Get(client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetResult) {
+	resp, err := client.Get(migrationURL(client, serverID, migrationID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ForceComplete forces an in-progress live migration of a server to complete,
+// by pausing the server on the source host.
+// This requires microversion 2.22 or later.
+func ForceComplete(client *gophercloud.ServiceClient, serverID string, migrationID int) (r ForceCompleteResult) {
+	b := map[string]interface{}{"force_complete": nil}
+	resp, err := client.Post(actionURL(client, serverID, migrationID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Abort aborts an in-progress live migration of a server.
+// This requires microversion 2.24 or later.
+func Abort(client *gophercloud.ServiceClient, serverID string, migrationID int) (r AbortResult) {
+	resp, err := client.Delete(migrationURL(client, serverID, migrationID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// MigrationType is the type of a migration.
+type MigrationType string
+
+const (
+	MigrationTypeMigration     MigrationType = "migration"
+	MigrationTypeLiveMigration MigrationType = "live-migration"
+	MigrationTypeEvacuation    MigrationType = "evacuation"
+	MigrationTypeResize        MigrationType = "resize"
+)
+
+// ListAllOptsBuilder allows extensions to add additional parameters to the
+// ListAll request.
+type ListAllOptsBuilder interface {
+	ToMigrationListAllQuery() (string, error)
+}
+
+// ListAllOpts represents options used to filter the migrations of all servers
+// in a ListAll request.
+type ListAllOpts struct {
+	// Hidden filters the response by the hidden status of the migrations.
+	Hidden *bool `q:"hidden"`
+
+	// Host filters the response by the source or destination compute host
+	// of the migrations.
+	Host string `q:"host"`
+
+	// InstanceUUID filters the response by the server of the migrations.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// MigrationType filters the response by the type of the migrations.
+	MigrationType MigrationType `q:"migration_type"`
+
+	// SourceCompute filters the response by the source compute service of the
+	// migrations.
+	SourceCompute string `q:"source_compute"`
+
+	// Status filters the response by the status of the migrations.
+	Status string `q:"status"`
+
+	// Limit is the maximum number of migrations to return.
+	// This requires microversion 2.59 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last-seen migration.
+	// This requires microversion 2.59 or later.
+	Marker string `q:"marker"`
+
+	// ChangesSince filters the response by migrations updated at or after
+	// the given time.
+	// This requires microversion 2.59 or later.
+	ChangesSince *time.Time `q:"changes-since"`
+
+	// ChangesBefore filters the response by migrations updated at or before
+	// the given time.
+	// This requires microversion 2.66 or later.
+	ChangesBefore *time.Time `q:"changes-before"`
+
+	// UserID filters the response by the user who initiated the migrations.
+	// This requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters the response by the project of the servers.
+	// This requires microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+}
+
+// ToMigrationListAllQuery formats a ListAllOpts into a query string.
+func (opts ListAllOpts) ToMigrationListAllQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// ListAll lists the migrations of all servers. It is restricted to
+// administrators by default.
+func ListAll(client *gophercloud.ServiceClient, opts ListAllOptsBuilder) pagination.Pager {
+	url := listAllURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListAllQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,262 @@
+package servermigrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ServerMigration represents an in-progress live migration of a server.
+type ServerMigration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// ServerUUID is the UUID of the server.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// SourceCompute is the source compute service.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute service.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// MemoryTotalBytes is the amount of memory to transfer, in bytes.
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+
+	// MemoryProcessedBytes is the amount of memory transferred, in bytes.
+	MemoryProcessedBytes int64 `json:"memory_processed_bytes"`
+
+	// MemoryRemainingBytes is the amount of memory left to transfer, in
+	// bytes.
+	MemoryRemainingBytes int64 `json:"memory_remaining_bytes"`
+
+	// DiskTotalBytes is the amount of disk to transfer, in bytes.
+	DiskTotalBytes int64 `json:"disk_total_bytes"`
+
+	// DiskProcessedBytes is the amount of disk transferred, in bytes.
+	DiskProcessedBytes int64 `json:"disk_processed_bytes"`
+
+	// DiskRemainingBytes is the amount of disk left to transfer, in bytes.
+	DiskRemainingBytes int64 `json:"disk_remaining_bytes"`
+
+	// UserID is the ID of the user who initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the time the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the time the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our server migration
+// struct.
+func (r *ServerMigration) UnmarshalJSON(b []byte) error {
+	type tmp ServerMigration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ  `json:"created_at"`
+		UpdatedAt *gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ServerMigration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	if s.UpdatedAt != nil {
+		r.UpdatedAt = time.Time(*s.UpdatedAt)
+	}
+
+	return nil
+}
+
+// ServerMigrationPage contains a single page of all in-progress live
+// migrations of a server from a List call.
+type ServerMigrationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a ServerMigrationPage contains no migrations.
+func (r ServerMigrationPage) IsEmpty() (bool, error) {
+	migrations, err := ExtractServerMigrations(r)
+	return len(migrations) == 0, err
+}
+
+// ExtractServerMigrations interprets a page of results as a slice of
+// ServerMigrations.
+func ExtractServerMigrations(r pagination.Page) ([]ServerMigration, error) {
+	var s struct {
+		Migrations []ServerMigration `json:"migrations"`
+	}
+	err := (r.(ServerMigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a ServerMigration.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a ServerMigration.
+func (r GetResult) Extract() (*ServerMigration, error) {
+	var s struct {
+		Migration *ServerMigration `json:"migration"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Migration, err
+}
+
+// ForceCompleteResult is the response from a ForceComplete operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ForceCompleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AbortResult is the response from an Abort operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type AbortResult struct {
+	gophercloud.ErrResult
+}
+
+// Migration represents a migration of a server, as listed by ListAll.
+type Migration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// InstanceUUID is the UUID of the server.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// MigrationType is the type of the migration.
+	// This requires microversion 2.23 or later.
+	MigrationType MigrationType `json:"migration_type"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// SourceCompute is the source compute service.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute service.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// OldInstanceTypeID is the ID of the flavor of the server before the
+	// migration.
+	OldInstanceTypeID int `json:"old_instance_type_id"`
+
+	// NewInstanceTypeID is the ID of the flavor of the server after the
+	// migration.
+	NewInstanceTypeID int `json:"new_instance_type_id"`
+
+	// Links are the links to the server migration, for in-progress live
+	// migrations.
+	// This requires microversion 2.23 or later.
+	Links []gophercloud.Link `json:"links"`
+
+	// UserID is the ID of the user who initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the time the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the time the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our migration struct.
+func (r *Migration) UnmarshalJSON(b []byte) error {
+	type tmp Migration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ  `json:"created_at"`
+		UpdatedAt *gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Migration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	if s.UpdatedAt != nil {
+		r.UpdatedAt = time.Time(*s.UpdatedAt)
+	}
+
+	return nil
+}
+
+// MigrationPage contains a single page of migrations from a ListAll call.
+type MigrationPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a MigrationPage contains no migrations.
+func (r MigrationPage) IsEmpty() (bool, error) {
+	migrations, err := ExtractMigrations(r)
+	return len(migrations) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Migrations are paginated from microversion 2.59.
+func (r MigrationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"migrations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMigrations interprets a page of results as a slice of Migrations.
+func ExtractMigrations(r pagination.Page) ([]Migration, error) {
+	var s struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	err := (r.(MigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
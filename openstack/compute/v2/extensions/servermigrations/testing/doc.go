@@ -0,0 +1,2 @@
+// servermigrations unit tests
+package testing
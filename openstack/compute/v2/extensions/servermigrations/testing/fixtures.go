@@ -0,0 +1,269 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/servermigrations"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+const serverID = "4cfba335-03d8-49b2-8c52-e69043d1e8fe"
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1,
+            "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "memory_total_bytes": 123456,
+            "memory_processed_bytes": 12345,
+            "memory_remaining_bytes": 111111,
+            "disk_total_bytes": 234567,
+            "disk_processed_bytes": 23456,
+            "disk_remaining_bytes": 211111,
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+            "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+            "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "migration": {
+        "created_at": "2016-01-29T13:42:02.000000",
+        "dest_compute": "compute2",
+        "dest_host": "1.2.3.4",
+        "dest_node": "node2",
+        "id": 1,
+        "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+        "source_compute": "compute1",
+        "source_node": "node1",
+        "status": "running",
+        "memory_total_bytes": 123456,
+        "memory_processed_bytes": 12345,
+        "memory_remaining_bytes": 111111,
+        "disk_total_bytes": 234567,
+        "disk_processed_bytes": 23456,
+        "disk_remaining_bytes": 211111,
+        "updated_at": "2016-01-29T13:42:02.000000",
+        "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+        "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+        "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+    }
+}
+`
+
+// ListAllOutput is a sample response to a ListAll call, with a link to a
+// second page.
+const ListAllOutput = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-06-23T14:42:02.000000",
+            "dest_compute": "compute20",
+            "dest_host": "5.6.7.8",
+            "dest_node": "node20",
+            "id": 4,
+            "instance_uuid": "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+            "new_instance_type_id": 6,
+            "old_instance_type_id": 5,
+            "source_compute": "compute10",
+            "source_node": "node10",
+            "status": "migrating",
+            "migration_type": "live-migration",
+            "links": [
+                {
+                    "href": "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/8600d31b-d1a1-4632-b2ff-45c2be1a70ff/migrations/4",
+                    "rel": "self"
+                }
+            ],
+            "updated_at": "2016-06-23T14:42:02.000000",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s/os-migrations?marker=42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// ListAllSecondPageOutput is the second page of ListAllOutput.
+const ListAllSecondPageOutput = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T11:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1,
+            "instance_uuid": "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+            "new_instance_type_id": 1,
+            "old_instance_type_id": 1,
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "finished",
+            "migration_type": "migration",
+            "updated_at": null,
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ],
+    "migrations_links": []
+}
+`
+
+// ExpectedServerMigration is the ServerMigration of ListOutput and GetOutput.
+var ExpectedServerMigration = servermigrations.ServerMigration{
+	ID:                   1,
+	UUID:                 "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           serverID,
+	Status:               "running",
+	SourceCompute:        "compute1",
+	SourceNode:           "node1",
+	DestCompute:          "compute2",
+	DestHost:             "1.2.3.4",
+	DestNode:             "node2",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       234567,
+	DiskProcessedBytes:   23456,
+	DiskRemainingBytes:   211111,
+	UserID:               "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+	ProjectID:            "5f705771-3aa9-4f4c-8660-0d9522ffdbea",
+	CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// ExpectedMigrations are the Migrations of both pages of ListAllOutput.
+var ExpectedMigrations = []servermigrations.Migration{
+	{
+		ID:                4,
+		UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+		InstanceUUID:      "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+		MigrationType:     servermigrations.MigrationTypeLiveMigration,
+		Status:            "migrating",
+		SourceCompute:     "compute10",
+		SourceNode:        "node10",
+		DestCompute:       "compute20",
+		DestHost:          "5.6.7.8",
+		DestNode:          "node20",
+		OldInstanceTypeID: 5,
+		NewInstanceTypeID: 6,
+		Links: []gophercloud.Link{
+			{
+				Href: "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/8600d31b-d1a1-4632-b2ff-45c2be1a70ff/migrations/4",
+				Rel:  "self",
+			},
+		},
+		CreatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+		UpdatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+	},
+	{
+		ID:                1,
+		UUID:              "12341d4b-346a-40d0-83c6-5f4f6892b650",
+		InstanceUUID:      "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+		MigrationType:     servermigrations.MigrationTypeMigration,
+		Status:            "finished",
+		SourceCompute:     "compute1",
+		SourceNode:        "node1",
+		DestCompute:       "compute2",
+		DestHost:          "1.2.3.4",
+		DestNode:          "node2",
+		OldInstanceTypeID: 1,
+		NewInstanceTypeID: 1,
+		CreatedAt:         time.Date(2016, 1, 29, 11, 42, 2, 0, time.UTC),
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleForceCompleteSuccessfully configures the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "force_complete": null }`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleAbortSuccessfully configures the test server to respond to an Abort
+// request.
+func HandleAbortSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleListAllSuccessfully configures the test server to respond to the
+// requests of the pages of a ListAll call.
+func HandleListAllSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"host":           "compute10",
+				"migration_type": "live-migration",
+				"hidden":         "false",
+				"changes-since":  "2016-06-01T00:00:00Z",
+			})
+			fmt.Fprintf(w, ListAllOutput, th.Server.URL)
+		case "42341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, ListAllSecondPageOutput)
+		default:
+			t.Errorf("Unexpected marker %s", r.URL.Query().Get("marker"))
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/servermigrations"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages := 0
+	err := servermigrations.List(client.ServiceClient(), serverID).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := servermigrations.ExtractServerMigrations(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []servermigrations.ServerMigration{ExpectedServerMigration}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := servermigrations.Get(client.ServiceClient(), serverID, 1).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServerMigration, *actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceCompleteSuccessfully(t)
+
+	err := servermigrations.ForceComplete(client.ServiceClient(), serverID, 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAbortSuccessfully(t)
+
+	err := servermigrations.Abort(client.ServiceClient(), serverID, 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAllSuccessfully(t)
+
+	hidden := false
+	since := time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)
+	listOpts := servermigrations.ListAllOpts{
+		Host:          "compute10",
+		MigrationType: servermigrations.MigrationTypeLiveMigration,
+		Hidden:        &hidden,
+		ChangesSince:  &since,
+	}
+
+	allPages, err := servermigrations.ListAll(client.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := servermigrations.ExtractMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedMigrations, actual)
+}
@@ -0,0 +1,23 @@
+package servermigrations
+
+import (
+	"strconv"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+func listURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "migrations")
+}
+
+func migrationURL(client *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return client.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID))
+}
+
+func actionURL(client *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return client.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID), "action")
+}
+
+func listAllURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("os-migrations")
+}
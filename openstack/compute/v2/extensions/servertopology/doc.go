@@ -0,0 +1,21 @@
+/*
+Package servertopology provides the ability to retrieve the NUMA topology of a
+server. This requires microversion 2.78 or later. The host NUMA nodes and CPU
+pinning of the server are only returned to administrators.
+
+Example to Get the Topology of a Server
+
+	computeClient.Microversion = "2.78"
+
+	topology, err := servertopology.Get(computeClient, "b546af1e-3893-44ea-a660-c6b998a64ba7").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, node := range topology.Nodes {
+		if node.HostNode != nil {
+			fmt.Printf("vCPUs %v on host node %d, pinned to %v\n", node.VCPUSet, *node.HostNode, node.CPUPinning)
+		}
+	}
+*/
+package servertopology
@@ -0,0 +1,15 @@
+package servertopology
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// Get retrieves the NUMA topology of a server.
+// This requires microversion 2.78 or later.
+func Get(client *gophercloud.ServiceClient, serverID string) (r GetResult) {
+	resp, err := client.Get(getURL(client, serverID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,51 @@
+package servertopology
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// Topology is the NUMA topology of a server.
+type Topology struct {
+	// Nodes are the NUMA nodes of the server. It is empty if the server has
+	// no NUMA topology.
+	Nodes []Node `json:"nodes"`
+
+	// PagesizeKB is the size of the memory pages of the server, in KiB, or 0
+	// if the server does not request a page size.
+	PagesizeKB int `json:"pagesize_kb"`
+}
+
+// Node is a NUMA node of a server.
+type Node struct {
+	// MemoryMB is the memory of the node, in MiB.
+	MemoryMB int `json:"memory_mb"`
+
+	// VCPUSet are the virtual CPUs of the node.
+	VCPUSet []int `json:"vcpu_set"`
+
+	// Siblings are the groups of virtual CPUs that are threads of the same
+	// core.
+	Siblings [][]int `json:"siblings"`
+
+	// HostNode is the host NUMA node the node is placed on. It is only
+	// returned to administrators.
+	HostNode *int `json:"host_node"`
+
+	// CPUPinning maps the virtual CPUs of the node to the host CPUs they are
+	// pinned to. It is only returned to administrators, and is empty if the
+	// server does not use dedicated CPUs.
+	CPUPinning map[int]int `json:"cpu_pinning"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a Topology.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Topology.
+func (r GetResult) Extract() (*Topology, error) {
+	var s Topology
+	err := r.ExtractInto(&s)
+	return &s, err
+}
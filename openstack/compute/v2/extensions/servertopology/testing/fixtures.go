@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/servertopology"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+const serverID = "b546af1e-3893-44ea-a660-c6b998a64ba7"
+
+// GetOutput is a sample response to a Get call, as seen by an administrator.
+const GetOutput = `
+{
+    "nodes": [
+        {
+            "cpu_pinning": {
+                "0": 0,
+                "1": 5
+            },
+            "host_node": 0,
+            "memory_mb": 1024,
+            "siblings": [
+                [0, 1]
+            ],
+            "vcpu_set": [0, 1]
+        },
+        {
+            "cpu_pinning": {
+                "2": 1,
+                "3": 8
+            },
+            "host_node": 1,
+            "memory_mb": 2048,
+            "siblings": [
+                [2, 3]
+            ],
+            "vcpu_set": [2, 3]
+        }
+    ],
+    "pagesize_kb": 4
+}
+`
+
+var hostNodes = []int{0, 1}
+
+// ExpectedTopology is the Topology of GetOutput.
+var ExpectedTopology = servertopology.Topology{
+	Nodes: []servertopology.Node{
+		{
+			MemoryMB:   1024,
+			VCPUSet:    []int{0, 1},
+			Siblings:   [][]int{{0, 1}},
+			HostNode:   &hostNodes[0],
+			CPUPinning: map[int]int{0: 0, 1: 5},
+		},
+		{
+			MemoryMB:   2048,
+			VCPUSet:    []int{2, 3},
+			Siblings:   [][]int{{2, 3}},
+			HostNode:   &hostNodes[1],
+			CPUPinning: map[int]int{2: 1, 3: 8},
+		},
+	},
+	PagesizeKB: 4,
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/topology", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
@@ -0,0 +1,19 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/servertopology"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := servertopology.Get(client.ServiceClient(), serverID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedTopology, *actual)
+}
@@ -0,0 +1,57 @@
+/*
+Package provision provides a high-level helper to provision servers ready to
+be used: it creates a server from a declarative Spec, waits for it to become
+ACTIVE and associates a floating IP with its port, deleting what it created if
+any of these steps fails.
+
+Example to Provision a Server with a Floating IP
+
+	provisioner := provision.Provisioner{
+		Compute: computeClient,
+		Network: networkClient,
+	}
+
+	spec := provision.Spec{
+		Name:       "web-1",
+		ImageID:    "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorName: "m1.small",
+		Networks: []servers.Network{
+			{UUID: "d32019d3-bc6e-4319-9c1d-6722fc136a22"},
+		},
+		SecurityGroups: []string{"web"},
+		KeyName:        "deploy",
+		UserData:       []byte("#cloud-config\npackages: [nginx]\n"),
+		FloatingIP: &provision.FloatingIPSpec{
+			NetworkName: "public",
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	result, err := provisioner.Provision(ctx, spec)
+	if err != nil {
+		var failed provision.ErrProvisionFailed
+		if errors.As(err, &failed) && failed.RollbackErr != nil {
+			fmt.Printf("server %s may have been left behind\n", failed.ServerID)
+		}
+		panic(err)
+	}
+
+	fmt.Printf("server %s is reachable at %s\n", result.Server.ID, result.FloatingIP.FloatingIP)
+
+Example to Provision a Server Booting from a New Volume
+
+	spec := provision.Spec{
+		Name:       "db-1",
+		ImageID:    "f90f6034-2570-4974-8351-6b49732ef2eb",
+		VolumeSize: 50,
+		FlavorID:   "3",
+	}
+
+	result, err := provisioner.Provision(context.Background(), spec)
+	if err != nil {
+		panic(err)
+	}
+*/
+package provision
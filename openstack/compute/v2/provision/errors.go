@@ -0,0 +1,122 @@
+package provision
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// Step is a step of Provision.
+type Step string
+
+const (
+	StepResolveFlavor    Step = "resolve flavor"
+	StepResolveNetwork   Step = "resolve floating IP network"
+	StepCreateServer     Step = "create server"
+	StepWaitActive       Step = "wait for server to become ACTIVE"
+	StepLookupPort       Step = "look up server port"
+	StepCreateFloatingIP Step = "create floating IP"
+	StepGetServer        Step = "get server"
+)
+
+// ErrProvisionFailed is returned by Provision when one of its steps fails.
+// The resources created by the previous steps have been deleted, unless
+// RollbackErr is set.
+type ErrProvisionFailed struct {
+	gophercloud.BaseError
+
+	// Step is the step that failed.
+	Step Step
+
+	// ServerID is the ID of the server, if it was created.
+	ServerID string
+
+	// Err is the error of Step.
+	Err error
+
+	// RollbackErr is the error that occurred while deleting the resources
+	// created by the previous steps, if any. It is an ErrRollbackFailed.
+	RollbackErr error
+}
+
+func (e ErrProvisionFailed) Error() string {
+	msg := fmt.Sprintf("Failed to %s", e.Step)
+	if e.ServerID != "" {
+		msg += fmt.Sprintf(" for server %s", e.ServerID)
+	}
+	msg += fmt.Sprintf(": %s", e.Err)
+	if e.RollbackErr != nil {
+		msg += fmt.Sprintf(" (rollback failed: %s)", e.RollbackErr)
+	}
+	e.DefaultErrString = msg
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+func (e ErrProvisionFailed) Unwrap() error {
+	return e.Err
+}
+
+// ErrRollbackFailed is the RollbackErr of an ErrProvisionFailed. It holds the
+// errors of the deletions that failed, each resource being deleted even if
+// the deletion of another one failed.
+type ErrRollbackFailed struct {
+	gophercloud.BaseError
+
+	// Errs are the errors of the failed deletions.
+	Errs []error
+}
+
+func (e ErrRollbackFailed) Error() string {
+	msgs := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs[i] = err.Error()
+	}
+	e.DefaultErrString = strings.Join(msgs, "; ")
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrNoPortFound is the error of StepLookupPort when the server has no port
+// with an IPv4 address on which to associate the floating IP.
+type ErrNoPortFound struct {
+	gophercloud.BaseError
+
+	// NetworkID is the network the port was looked up on, if any.
+	NetworkID string
+}
+
+func (e ErrNoPortFound) Error() string {
+	if e.NetworkID != "" {
+		e.DefaultErrString = fmt.Sprintf("Server has no port with an IPv4 address on network %s", e.NetworkID)
+	} else {
+		e.DefaultErrString = "Server has no port with an IPv4 address"
+	}
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrMultiplePortsFound is the error of StepLookupPort when PortNetworkID is
+// not set and the server has several ports with an IPv4 address, since
+// Neutron lists them in no particular order.
+type ErrMultiplePortsFound struct {
+	gophercloud.BaseError
+
+	// Count is the number of ports with an IPv4 address.
+	Count int
+}
+
+func (e ErrMultiplePortsFound) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Server has %d ports with an IPv4 address, PortNetworkID must be set to choose one", e.Count)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
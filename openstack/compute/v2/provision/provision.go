@@ -0,0 +1,394 @@
+package provision
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/schedulerhints"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/flavors"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/servers"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/networking/v2/networks"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/networking/v2/ports"
+)
+
+// Spec declares the server to provision.
+type Spec struct {
+	// Name is the name of the server.
+	Name string
+
+	// ImageID is the image to boot the server from. If VolumeSize is set, the
+	// server boots from a new volume created from the image, which is deleted
+	// with the server.
+	ImageID string
+
+	// VolumeSize is the size, in GB, of the volume to create from ImageID.
+	VolumeSize int
+
+	// VolumeID is an existing volume to boot the server from, instead of
+	// ImageID. It is not deleted with the server.
+	VolumeID string
+
+	// FlavorID is the flavor of the server.
+	FlavorID string
+
+	// FlavorName is the name of the flavor of the server, used if FlavorID
+	// is not set.
+	FlavorName string
+
+	// Networks are the networks and ports to attach the server to. If empty,
+	// the server is attached according to the default policy of the Compute
+	// service.
+	Networks []servers.Network
+
+	// SecurityGroups are the names of the security groups of the server.
+	SecurityGroups []string
+
+	// UserData is the user data of the server.
+	UserData []byte
+
+	// KeyName is the name of the key pair to inject into the server.
+	KeyName string
+
+	// AvailabilityZone is the availability zone of the server.
+	AvailabilityZone string
+
+	// Metadata is the metadata of the server.
+	Metadata map[string]string
+
+	// SchedulerHints are the scheduler hints of the server.
+	SchedulerHints *schedulerhints.SchedulerHints
+
+	// FloatingIP, if set, makes Provision associate a new floating IP with
+	// the server.
+	FloatingIP *FloatingIPSpec
+}
+
+// FloatingIPSpec declares the floating IP of the server to provision.
+type FloatingIPSpec struct {
+	// NetworkID is the external network to allocate the floating IP from.
+	NetworkID string
+
+	// NetworkName is the name of the external network to allocate the
+	// floating IP from, used if NetworkID is not set.
+	NetworkName string
+
+	// PortNetworkID is the network of the server port to associate the
+	// floating IP with. It may only be omitted if the server has a single
+	// port with an IPv4 address.
+	PortNetworkID string
+}
+
+// Result is the result of Provision.
+type Result struct {
+	// Server is the ACTIVE server.
+	Server *servers.Server
+
+	// FloatingIP is the floating IP associated with Server, if requested.
+	FloatingIP *floatingips.FloatingIP
+}
+
+// Provisioner provisions servers with the Compute and Networking services.
+type Provisioner struct {
+	// Compute is the client of the Compute service.
+	Compute *gophercloud.ServiceClient
+
+	// Network is the client of the Networking service. It is only required
+	// to provision servers with a floating IP.
+	Network *gophercloud.ServiceClient
+
+	// Interval is the delay between two polls of the server while waiting
+	// for it to become ACTIVE. It defaults to the one of
+	// servers.NewStatusWaiter.
+	Interval time.Duration
+}
+
+/*
+Provision creates the server declared by spec, waits for it to become ACTIVE,
+and associates a floating IP with its port if requested. It returns the server
+as fetched after these steps.
+
+The addresses of the returned server include its fixed IPs. The Compute
+service refreshes the addresses of servers asynchronously, so that they may
+not include the floating IP yet, which Result.FloatingIP always holds.
+
+The requests are bound to ctx, and the wait for the server fails when ctx is
+done. If any step fails, the floating IP and the server created by the
+previous steps are deleted, and an ErrProvisionFailed is returned.
+*/
+func (p Provisioner) Provision(ctx context.Context, spec Spec) (*Result, error) {
+	compute := p.Compute.WithContext(ctx)
+
+	if spec.FloatingIP != nil && p.Network == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "provision.Provisioner.Network"
+		return nil, err
+	}
+
+	createOpts, err := p.createOpts(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var floatingNetworkID string
+	if spec.FloatingIP != nil {
+		floatingNetworkID, err = p.floatingNetworkID(ctx, *spec.FloatingIP)
+		if err != nil {
+			return nil, ErrProvisionFailed{Step: StepResolveNetwork, Err: err}
+		}
+	}
+
+	server, err := servers.Create(compute, createOpts).Extract()
+	if err != nil {
+		return nil, ErrProvisionFailed{Step: StepCreateServer, Err: err}
+	}
+
+	result := &Result{Server: server}
+	fail := func(step Step, err error) (*Result, error) {
+		return nil, ErrProvisionFailed{
+			Step:        step,
+			ServerID:    server.ID,
+			Err:         err,
+			RollbackErr: p.rollback(result),
+		}
+	}
+
+	waiter := servers.NewStatusWaiter(p.Compute, server.ID, "ACTIVE")
+	if p.Interval > 0 {
+		waiter.Interval = p.Interval
+	}
+	if _, err := waiter.Wait(ctx); err != nil {
+		return fail(StepWaitActive, err)
+	}
+
+	if spec.FloatingIP != nil {
+		port, fixedIP, err := p.port(ctx, server.ID, spec.FloatingIP.PortNetworkID)
+		if err != nil {
+			return fail(StepLookupPort, err)
+		}
+
+		fip, err := floatingips.Create(p.Network.WithContext(ctx), floatingips.CreateOpts{
+			FloatingNetworkID: floatingNetworkID,
+			PortID:            port,
+			FixedIP:           fixedIP,
+		}).Extract()
+		if err != nil {
+			return fail(StepCreateFloatingIP, err)
+		}
+		result.FloatingIP = fip
+	}
+
+	server, err = servers.Get(compute, server.ID).Extract()
+	if err != nil {
+		return fail(StepGetServer, err)
+	}
+	result.Server = server
+
+	return result, nil
+}
+
+// createOpts builds the servers.CreateOptsBuilder of spec, resolving the
+// flavor name.
+func (p Provisioner) createOpts(ctx context.Context, spec Spec) (servers.CreateOptsBuilder, error) {
+	if spec.Name == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "provision.Spec.Name"
+		return nil, err
+	}
+	if (spec.ImageID == "") == (spec.VolumeID == "") {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "provision.Spec.ImageID or provision.Spec.VolumeID"
+		err.Info = "One and only one of them must be provided"
+		return nil, err
+	}
+	if spec.FlavorID == "" && spec.FlavorName == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "provision.Spec.FlavorID or provision.Spec.FlavorName"
+		return nil, err
+	}
+
+	flavorID := spec.FlavorID
+	if flavorID == "" {
+		var err error
+		flavorID, err = p.flavorID(ctx, spec.FlavorName)
+		if err != nil {
+			return nil, ErrProvisionFailed{Step: StepResolveFlavor, Err: err}
+		}
+	}
+
+	serverOpts := servers.CreateOpts{
+		Name:             spec.Name,
+		FlavorRef:        flavorID,
+		SecurityGroups:   spec.SecurityGroups,
+		UserData:         spec.UserData,
+		AvailabilityZone: spec.AvailabilityZone,
+		Metadata:         spec.Metadata,
+	}
+	if len(spec.Networks) > 0 {
+		serverOpts.Networks = spec.Networks
+	}
+	if spec.VolumeID == "" && spec.VolumeSize == 0 {
+		serverOpts.ImageRef = spec.ImageID
+	}
+
+	var opts servers.CreateOptsBuilder = serverOpts
+	switch {
+	case spec.VolumeID != "":
+		opts = bootfromvolume.CreateOptsExt{
+			CreateOptsBuilder: opts,
+			BlockDevice: []bootfromvolume.BlockDevice{{
+				SourceType:      bootfromvolume.SourceVolume,
+				DestinationType: bootfromvolume.DestinationVolume,
+				UUID:            spec.VolumeID,
+			}},
+		}
+	case spec.VolumeSize > 0:
+		opts = bootfromvolume.CreateOptsExt{
+			CreateOptsBuilder: opts,
+			BlockDevice: []bootfromvolume.BlockDevice{{
+				SourceType:          bootfromvolume.SourceImage,
+				DestinationType:     bootfromvolume.DestinationVolume,
+				UUID:                spec.ImageID,
+				VolumeSize:          spec.VolumeSize,
+				DeleteOnTermination: true,
+			}},
+		}
+	}
+
+	if spec.KeyName != "" {
+		opts = keypairs.CreateOptsExt{
+			CreateOptsBuilder: opts,
+			KeyName:           spec.KeyName,
+		}
+	}
+	if spec.SchedulerHints != nil {
+		opts = schedulerhints.CreateOptsExt{
+			CreateOptsBuilder: opts,
+			SchedulerHints:    *spec.SchedulerHints,
+		}
+	}
+
+	return opts, nil
+}
+
+// flavorID returns the ID of the flavor called name.
+func (p Provisioner) flavorID(ctx context.Context, name string) (string, error) {
+	pages, err := flavors.ListDetail(p.Compute.WithContext(ctx), nil).AllPages()
+	if err != nil {
+		return "", err
+	}
+	all, err := flavors.ExtractFlavors(pages)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, f := range all {
+		if f.Name == name {
+			ids = append(ids, f.ID)
+		}
+	}
+	return uniqueID(ids, name, "flavor")
+}
+
+// floatingNetworkID returns the ID of the external network of spec.
+func (p Provisioner) floatingNetworkID(ctx context.Context, spec FloatingIPSpec) (string, error) {
+	if spec.NetworkID != "" {
+		return spec.NetworkID, nil
+	}
+	if spec.NetworkName == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "provision.FloatingIPSpec.NetworkID or provision.FloatingIPSpec.NetworkName"
+		return "", err
+	}
+
+	pages, err := networks.List(p.Network.WithContext(ctx), networks.ListOpts{Name: spec.NetworkName}).AllPages()
+	if err != nil {
+		return "", err
+	}
+	all, err := networks.ExtractNetworks(pages)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, len(all))
+	for i, n := range all {
+		ids[i] = n.ID
+	}
+	return uniqueID(ids, spec.NetworkName, "network")
+}
+
+func uniqueID(ids []string, name, resourceType string) (string, error) {
+	switch len(ids) {
+	case 0:
+		err := gophercloud.ErrResourceNotFound{Name: name, ResourceType: resourceType}
+		return "", err
+	case 1:
+		return ids[0], nil
+	default:
+		err := gophercloud.ErrMultipleResourcesFound{Name: name, Count: len(ids), ResourceType: resourceType}
+		return "", err
+	}
+}
+
+// port returns the ID and the IPv4 address of the port of the server on
+// networkID, or of its only port with an IPv4 address if networkID is empty.
+func (p Provisioner) port(ctx context.Context, serverID, networkID string) (string, string, error) {
+	pages, err := ports.List(p.Network.WithContext(ctx), ports.ListOpts{
+		DeviceID:  serverID,
+		NetworkID: networkID,
+	}).AllPages()
+	if err != nil {
+		return "", "", err
+	}
+	all, err := ports.ExtractPorts(pages)
+	if err != nil {
+		return "", "", err
+	}
+
+	var portID, fixedIP string
+	count := 0
+	for _, port := range all {
+		for _, ip := range port.FixedIPs {
+			if addr := net.ParseIP(ip.IPAddress); addr != nil && addr.To4() != nil {
+				if count == 0 {
+					portID, fixedIP = port.ID, ip.IPAddress
+				}
+				count++
+				break
+			}
+		}
+	}
+
+	switch {
+	case count == 0:
+		return "", "", ErrNoPortFound{NetworkID: networkID}
+	case count > 1 && networkID == "":
+		return "", "", ErrMultiplePortsFound{Count: count}
+	}
+	return portID, fixedIP, nil
+}
+
+// rollback deletes the floating IP and the server of result. It does not
+// use the context of Provision, which may be done already.
+func (p Provisioner) rollback(result *Result) error {
+	var errs []error
+	if result.FloatingIP != nil {
+		if err := floatingips.Delete(p.Network, result.FloatingIP.ID).ExtractErr(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := servers.Delete(p.Compute, result.Server.ID).ExtractErr(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return ErrRollbackFailed{Errs: errs}
+}
@@ -0,0 +1,327 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+const (
+	serverID     = "9e5476bd-a4ec-4653-93d6-72c93aa682ba"
+	portID       = "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b"
+	floatingID   = "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	extNetworkID = "376da547-b977-4cfe-9cba-275c80debf57"
+)
+
+// FlavorListOutput is a sample response to a flavor list request.
+const FlavorListOutput = `
+{
+    "flavors": [
+        {
+            "id": "1",
+            "name": "m1.tiny",
+            "vcpus": 1,
+            "disk": 1,
+            "ram": 512
+        },
+        {
+            "id": "2",
+            "name": "m1.small",
+            "vcpus": 1,
+            "disk": 20,
+            "ram": 2048
+        }
+    ]
+}
+`
+
+// NetworkListOutput is a sample response to a network list request
+// filtered by name.
+const NetworkListOutput = `
+{
+    "networks": [
+        {
+            "id": "376da547-b977-4cfe-9cba-275c80debf57",
+            "name": "public",
+            "router:external": true,
+            "status": "ACTIVE"
+        }
+    ]
+}
+`
+
+// CreateRequest is the expected server create request of an image boot.
+const CreateRequest = `
+{
+    "server": {
+        "name": "web-1",
+        "imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+        "flavorRef": "2",
+        "key_name": "deploy",
+        "user_data": "I2Nsb3VkLWNvbmZpZw==",
+        "security_groups": [{"name": "web"}],
+        "networks": [{"uuid": "d32019d3-bc6e-4319-9c1d-6722fc136a22"}]
+    },
+    "os:scheduler_hints": {
+        "group": "101aed42-22d9-4a3e-9ba1-21103b0d1aba"
+    }
+}
+`
+
+// CreateFromVolumeRequest is the expected server create request of a boot
+// from a new volume.
+const CreateFromVolumeRequest = `
+{
+    "server": {
+        "name": "web-1",
+        "imageRef": "",
+        "flavorRef": "2",
+        "block_device_mapping_v2": [
+            {
+                "boot_index": 0,
+                "delete_on_termination": true,
+                "destination_type": "volume",
+                "source_type": "image",
+                "uuid": "f90f6034-2570-4974-8351-6b49732ef2eb",
+                "volume_size": 10
+            }
+        ]
+    }
+}
+`
+
+// CreateOutput is a sample response to a server create request.
+const CreateOutput = `
+{
+    "server": {
+        "id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+        "adminPass": "aabbccddeeff"
+    }
+}
+`
+
+// ServerOutput is a sample response to a server get request, where %s is
+// the status of the server.
+const ServerOutput = `
+{
+    "server": {
+        "id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+        "name": "web-1",
+        "status": "%s",
+        "fault": {
+            "code": 500,
+            "message": "No valid host was found."
+        },
+        "addresses": {
+            "private": [
+                {
+                    "addr": "10.0.0.5",
+                    "version": 4,
+                    "OS-EXT-IPS:type": "fixed"
+                },
+                {
+                    "addr": "172.24.4.10",
+                    "version": 4,
+                    "OS-EXT-IPS:type": "floating"
+                }
+            ]
+        }
+    }
+}
+`
+
+// PortListOutput is a sample response to a port list request filtered by
+// device.
+const PortListOutput = `
+{
+    "ports": [
+        {
+            "id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "device_id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "fd00::5"
+                },
+                {
+                    "subnet_id": "b0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.5"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// PortListMultipleOutput is a sample response to a port list request filtered
+// by device, for a server with two ports with an IPv4 address.
+const PortListMultipleOutput = `
+{
+    "ports": [
+        {
+            "id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "device_id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+            "fixed_ips": [
+                {
+                    "subnet_id": "b0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.5"
+                }
+            ]
+        },
+        {
+            "id": "e80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "network_id": "e32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "device_id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+            "fixed_ips": [
+                {
+                    "subnet_id": "c0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "192.168.0.5"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// FloatingIPCreateRequest is the expected floating IP create request.
+const FloatingIPCreateRequest = `
+{
+    "floatingip": {
+        "floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+        "port_id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+        "fixed_ip_address": "10.0.0.5"
+    }
+}
+`
+
+// FloatingIPCreateOutput is a sample response to a floating IP create
+// request.
+const FloatingIPCreateOutput = `
+{
+    "floatingip": {
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+        "floating_ip_address": "172.24.4.10",
+        "port_id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+        "fixed_ip_address": "10.0.0.5",
+        "status": "DOWN"
+    }
+}
+`
+
+// Calls records the requests received by the handlers.
+type Calls struct {
+	ServerGets      int
+	ServerDeleted   bool
+	FloatingDeleted bool
+	FloatingCreated bool
+}
+
+// HandleFlavorListSuccessfully configures the test server to respond to a
+// flavor list request.
+func HandleFlavorListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, FlavorListOutput)
+	})
+}
+
+// HandleNetworkListSuccessfully configures the test server to respond to a
+// network list request for the "public" network.
+func HandleNetworkListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": "public"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, NetworkListOutput)
+	})
+}
+
+// HandleServerSuccessfully configures the test server to respond to the
+// create, get and delete requests of a server. The server is in BUILD until
+// it is fetched for the second time, and then in status.
+func HandleServerSuccessfully(t *testing.T, createRequest, status string, calls *Calls) {
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateOutput)
+	})
+
+	th.Mux.HandleFunc("/servers/"+serverID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+			calls.ServerGets++
+			current := "BUILD"
+			if calls.ServerGets > 1 {
+				current = status
+			}
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, ServerOutput, current)
+		case "DELETE":
+			calls.ServerDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandlePortListSuccessfully configures the test server to respond to a port
+// list request for the ports of the server.
+func HandlePortListSuccessfully(t *testing.T) {
+	HandlePortList(t, PortListOutput)
+}
+
+// HandlePortList configures the test server to respond to a port list request
+// for the ports of the server with output.
+func HandlePortList(t *testing.T, output string) {
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"device_id": serverID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleFloatingIPCreate configures the test server to respond to a floating
+// IP create request with statusCode.
+func HandleFloatingIPCreate(t *testing.T, statusCode int, calls *Calls) {
+	th.Mux.HandleFunc("/v2.0/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, FloatingIPCreateRequest)
+
+		if statusCode != http.StatusCreated {
+			w.WriteHeader(statusCode)
+			return
+		}
+		calls.FloatingCreated = true
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, FloatingIPCreateOutput)
+	})
+
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		calls.FloatingDeleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,206 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/schedulerhints"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/provision"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/servers"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/networking/v2/common"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func newProvisioner() provision.Provisioner {
+	return provision.Provisioner{
+		Compute:  client.ServiceClient(),
+		Network:  common.ServiceClient(),
+		Interval: time.Millisecond,
+	}
+}
+
+func webSpec() provision.Spec {
+	return provision.Spec{
+		Name:           "web-1",
+		ImageID:        "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorName:     "m1.small",
+		Networks:       []servers.Network{{UUID: "d32019d3-bc6e-4319-9c1d-6722fc136a22"}},
+		SecurityGroups: []string{"web"},
+		UserData:       []byte("#cloud-config"),
+		KeyName:        "deploy",
+		SchedulerHints: &schedulerhints.SchedulerHints{
+			Group: "101aed42-22d9-4a3e-9ba1-21103b0d1aba",
+		},
+		FloatingIP: &provision.FloatingIPSpec{
+			NetworkName: "public",
+		},
+	}
+}
+
+func TestProvision(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := new(Calls)
+	HandleFlavorListSuccessfully(t)
+	HandleNetworkListSuccessfully(t)
+	HandleServerSuccessfully(t, CreateRequest, "ACTIVE", calls)
+	HandlePortListSuccessfully(t)
+	HandleFloatingIPCreate(t, http.StatusCreated, calls)
+
+	result, err := newProvisioner().Provision(context.Background(), webSpec())
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, serverID, result.Server.ID)
+	th.AssertEquals(t, "ACTIVE", result.Server.Status)
+	th.AssertEquals(t, 1, len(result.Server.Addresses))
+	th.AssertEquals(t, floatingID, result.FloatingIP.ID)
+	th.AssertEquals(t, "172.24.4.10", result.FloatingIP.FloatingIP)
+	th.AssertEquals(t, 3, calls.ServerGets)
+	th.AssertEquals(t, false, calls.ServerDeleted)
+}
+
+func TestProvisionFromVolume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := new(Calls)
+	HandleServerSuccessfully(t, CreateFromVolumeRequest, "ACTIVE", calls)
+
+	spec := provision.Spec{
+		Name:       "web-1",
+		ImageID:    "f90f6034-2570-4974-8351-6b49732ef2eb",
+		VolumeSize: 10,
+		FlavorID:   "2",
+	}
+
+	p := newProvisioner()
+	p.Network = nil
+	result, err := p.Provision(context.Background(), spec)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, serverID, result.Server.ID)
+	th.AssertEquals(t, true, result.FloatingIP == nil)
+}
+
+func TestProvisionServerError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := new(Calls)
+	HandleFlavorListSuccessfully(t)
+	HandleNetworkListSuccessfully(t)
+	HandleServerSuccessfully(t, CreateRequest, "ERROR", calls)
+
+	_, err := newProvisioner().Provision(context.Background(), webSpec())
+
+	var failed provision.ErrProvisionFailed
+	th.AssertEquals(t, true, errors.As(err, &failed))
+	th.AssertEquals(t, provision.StepWaitActive, failed.Step)
+	th.AssertEquals(t, serverID, failed.ServerID)
+	th.AssertNoErr(t, failed.RollbackErr)
+
+	var stateErr gophercloud.ErrStateFailure[*servers.Server]
+	th.AssertEquals(t, true, errors.As(err, &stateErr))
+	th.AssertEquals(t, "No valid host was found.", stateErr.Detail)
+
+	th.AssertEquals(t, true, calls.ServerDeleted)
+}
+
+func TestProvisionFloatingIPError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := new(Calls)
+	HandleFlavorListSuccessfully(t)
+	HandleNetworkListSuccessfully(t)
+	HandleServerSuccessfully(t, CreateRequest, "ACTIVE", calls)
+	HandlePortListSuccessfully(t)
+	HandleFloatingIPCreate(t, http.StatusNotFound, calls)
+
+	_, err := newProvisioner().Provision(context.Background(), webSpec())
+
+	var failed provision.ErrProvisionFailed
+	th.AssertEquals(t, true, errors.As(err, &failed))
+	th.AssertEquals(t, provision.StepCreateFloatingIP, failed.Step)
+	th.AssertNoErr(t, failed.RollbackErr)
+
+	th.AssertEquals(t, true, calls.ServerDeleted)
+	th.AssertEquals(t, false, calls.FloatingDeleted)
+}
+
+func TestProvisionUnknownFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFlavorListSuccessfully(t)
+
+	spec := webSpec()
+	spec.FlavorName = "m1.huge"
+	_, err := newProvisioner().Provision(context.Background(), spec)
+
+	var failed provision.ErrProvisionFailed
+	th.AssertEquals(t, true, errors.As(err, &failed))
+	th.AssertEquals(t, provision.StepResolveFlavor, failed.Step)
+	th.AssertEquals(t, "", failed.ServerID)
+
+	_, ok := failed.Err.(gophercloud.ErrResourceNotFound)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestProvisionInvalidSpec(t *testing.T) {
+	spec := webSpec()
+	spec.VolumeID = "2b2a0d36-8b57-4f1c-a9d6-8c3b1d32e4a8"
+	_, err := newProvisioner().Provision(context.Background(), spec)
+
+	_, ok := err.(gophercloud.ErrMissingInput)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestProvisionMultiplePorts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := new(Calls)
+	HandleFlavorListSuccessfully(t)
+	HandleNetworkListSuccessfully(t)
+	HandleServerSuccessfully(t, CreateRequest, "ACTIVE", calls)
+	HandlePortList(t, PortListMultipleOutput)
+
+	_, err := newProvisioner().Provision(context.Background(), webSpec())
+
+	var failed provision.ErrProvisionFailed
+	th.AssertEquals(t, true, errors.As(err, &failed))
+	th.AssertEquals(t, provision.StepLookupPort, failed.Step)
+	th.AssertNoErr(t, failed.RollbackErr)
+
+	var multiple provision.ErrMultiplePortsFound
+	th.AssertEquals(t, true, errors.As(err, &multiple))
+	th.AssertEquals(t, 2, multiple.Count)
+
+	th.AssertEquals(t, true, calls.ServerDeleted)
+	th.AssertEquals(t, false, calls.FloatingCreated)
+}
+
+func TestErrors(t *testing.T) {
+	rollbackErr := provision.ErrRollbackFailed{
+		Errs: []error{errors.New("delete floating IP"), errors.New("delete server")},
+	}
+	th.AssertEquals(t, "delete floating IP; delete server", rollbackErr.Error())
+
+	err := provision.ErrProvisionFailed{
+		Step:        provision.StepLookupPort,
+		ServerID:    serverID,
+		Err:         provision.ErrNoPortFound{NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22"},
+		RollbackErr: rollbackErr,
+	}
+	th.AssertEquals(t, "Failed to look up server port for server "+serverID+
+		": Server has no port with an IPv4 address on network d32019d3-bc6e-4319-9c1d-6722fc136a22"+
+		" (rollback failed: delete floating IP; delete server)", err.Error())
+
+	err.Info = "custom message"
+	th.AssertEquals(t, "custom message", err.Error())
+}
@@ -111,5 +111,22 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Wait for a Server to Become Active
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	waiter := servers.NewStatusWaiter(computeClient, serverID, "ACTIVE")
+	waiter.Multiplier = 1.5
+	waiter.MaxInterval = 30 * time.Second
+
+	server, err := waiter.Wait(ctx)
+	if failure, ok := err.(gophercloud.ErrStateFailure[*servers.Server]); ok {
+		fmt.Printf("Server went to %s: %s\n", failure.State, failure.Resource.Fault.Message)
+	}
+	if err != nil {
+		panic(err)
+	}
 */
 package servers
@@ -93,6 +93,12 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// ListIterator returns an Iterator over the servers returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(client *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Server] {
+	return pagination.NewIterator(List(client, opts), ExtractServers)
+}
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -218,13 +224,7 @@ func (opts CreateOpts) ToServerCreateMap() (map[string]interface{}, error) {
 	}
 
 	if opts.UserData != nil {
-		var userData string
-		if _, err := base64.StdEncoding.DecodeString(string(opts.UserData)); err != nil {
-			userData = base64.StdEncoding.EncodeToString(opts.UserData)
-		} else {
-			userData = string(opts.UserData)
-		}
-		b["user_data"] = &userData
+		b["user_data"] = encodeUserData(opts.UserData)
 	}
 
 	if len(opts.SecurityGroups) > 0 {
@@ -272,6 +272,17 @@ func (opts CreateOpts) ToServerCreateMap() (map[string]interface{}, error) {
 	return map[string]interface{}{"server": b}, nil
 }
 
+// encodeUserData base64-encodes userData, if it isn't already.
+func encodeUserData(userData []byte) *string {
+	var encoded string
+	if _, err := base64.StdEncoding.DecodeString(string(userData)); err != nil {
+		encoded = base64.StdEncoding.EncodeToString(userData)
+	} else {
+		encoded = string(userData)
+	}
+	return &encoded
+}
+
 // Create requests a server to be provisioned to the user in the current tenant.
 func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	reqBody, err := opts.ToServerCreateMap()
@@ -448,6 +459,11 @@ type RebuildOpts struct {
 	// Rebuild will base64-encode file contents for you.
 	Personality Personality `json:"personality,omitempty"`
 
+	// UserData [optional] replaces the user data of the server.
+	// Rebuild will base64-encode it for you, if it isn't already.
+	// This requires microversion 2.57 or later.
+	UserData []byte `json:"-"`
+
 	// ServiceClient will allow calls to be made to retrieve an image or
 	// flavor ID by name.
 	ServiceClient *gophercloud.ServiceClient `json:"-"`
@@ -460,6 +476,10 @@ func (opts RebuildOpts) ToServerRebuildMap() (map[string]interface{}, error) {
 		return nil, err
 	}
 
+	if opts.UserData != nil {
+		b["user_data"] = encodeUserData(opts.UserData)
+	}
+
 	return map[string]interface{}{"rebuild": b}, nil
 }
 
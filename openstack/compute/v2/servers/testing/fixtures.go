@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -958,6 +959,52 @@ func HandleServerGetFaultSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleServerGetErrorSuccessfully sets up the test server to respond to a
+// server Get request with a server in ERROR, which contains a fault.
+func HandleServerGetErrorSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		fmt.Fprint(w, strings.Replace(FaultyServerBody, `"status": "ACTIVE"`, `"status": "ERROR"`, 1))
+	})
+}
+
+// HandleServerGetNotFound sets up the test server to respond to a server Get
+// request for a deleted server.
+func HandleServerGetNotFound(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+// HandleServerDeleteErrorSuccessfully sets up the test server to respond to
+// the deletion of a server in ERROR. The server is reported in ERROR until
+// the second Get request, after which it is gone.
+func HandleServerDeleteErrorSuccessfully(t *testing.T) {
+	gets := 0
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			gets++
+			if gets > 2 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, strings.Replace(FaultyServerBody, `"status": "ACTIVE"`, `"status": "ERROR"`, 1))
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
 // HandleServerUpdateSuccessfully sets up the test server to respond to a server Update request.
 func HandleServerUpdateSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/diskconfig"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/extensions/extendedstatus"
@@ -59,6 +62,23 @@ func TestListAllServers(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, actual[1])
 }
 
+func TestListIterator(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListSuccessfully(t)
+
+	var actual []servers.Server
+	it := servers.ListIterator(client.ServiceClient(), servers.ListOpts{})
+	it.MaxItems = 2
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	th.AssertNoErr(t, it.Err())
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, ServerHerp, actual[0])
+	th.CheckDeepEquals(t, ServerDerp, actual[1])
+}
+
 func TestListAllServersWithExtensions(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -352,6 +372,26 @@ func TestRebuildServer(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestRebuildServerWithUserdata(t *testing.T) {
+	opts := servers.RebuildOpts{
+		ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		UserData: []byte("userdata string"),
+	}
+
+	expected := `
+		{
+			"rebuild": {
+				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+				"user_data": "dXNlcmRhdGEgc3RyaW5n"
+			}
+		}
+	`
+
+	actual, err := opts.ToServerRebuildMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
+
 func TestResizeServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -592,3 +632,49 @@ func TestCreateServerWithTags(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, ServerDerpTags, *actualServer)
 }
+
+func TestStatusWaiter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+
+	server, err := servers.NewStatusWaiter(client.ServiceClient(), "1234asdf", "ACTIVE").Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ACTIVE", server.Status)
+}
+
+func TestStatusWaiterError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetErrorSuccessfully(t)
+
+	_, err := servers.NewStatusWaiter(client.ServiceClient(), "1234asdf", "ACTIVE").Wait(context.Background())
+	failure, ok := err.(gophercloud.ErrStateFailure[*servers.Server])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "ERROR", failure.State)
+	th.AssertEquals(t, DerpFault.Message, failure.Detail)
+	th.AssertEquals(t, DerpFault.Code, failure.Resource.Fault.Code)
+}
+
+func TestDeleteWaiter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetNotFound(t)
+
+	_, err := servers.NewDeleteWaiter(client.ServiceClient(), "1234asdf").Wait(context.Background())
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteWaiterError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerDeleteErrorSuccessfully(t)
+
+	err := servers.Delete(client.ServiceClient(), "1234asdf").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	w := servers.NewDeleteWaiter(client.ServiceClient(), "1234asdf")
+	w.Interval = time.Millisecond
+	_, err = w.Wait(context.Background())
+	th.AssertNoErr(t, err)
+}
@@ -1,6 +1,10 @@
 package servers
 
-import "github.com/yogeshwargnanasekaran/gophercloud"
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
 
 // WaitForStatus will continually poll a server until it successfully
 // transitions to a specified status. It will do this for at most the number
@@ -19,3 +23,50 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 		return false, nil
 	})
 }
+
+// WaitForStatusContext will continually poll a server until it successfully
+// transitions to a specified status, or until ctx is done. The Get requests
+// are bound to ctx, so cancelling it aborts an in-flight poll.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
+	return gophercloud.WaitForContext(ctx, func(ctx context.Context) (bool, error) {
+		current, err := Get(c.WithContext(ctx), id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the server
+// to reach one of the target statuses. It fails as soon as the server goes to
+// ERROR, with the fault of the server.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*Server] {
+	return &gophercloud.StateWaiter[*Server]{
+		Get: func(ctx context.Context) (*Server, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(s *Server) string {
+			return s.Status
+		},
+		Detail: func(s *Server) string {
+			return s.Fault.Message
+		},
+		Target:  target,
+		Failure: []string{"ERROR"},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the server
+// to be deleted. Unlike NewStatusWaiter, it does not fail on ERROR, since
+// servers in ERROR can be deleted.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Server] {
+	w := NewStatusWaiter(c, id, "DELETED")
+	w.Failure = nil
+	w.NotFoundIsTarget = true
+	return w
+}
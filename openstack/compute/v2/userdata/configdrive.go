@@ -0,0 +1,187 @@
+package userdata
+
+import (
+	"encoding/json"
+)
+
+// The paths of the files of a config drive rendered by ConfigDrive.Files.
+const (
+	MetaDataPath    = "openstack/latest/meta_data.json"
+	NetworkDataPath = "openstack/latest/network_data.json"
+	UserDataPath    = "openstack/latest/user_data"
+)
+
+// MetaData is the content of the meta_data.json file of the config drive and
+// of the metadata service.
+type MetaData struct {
+	// UUID is the ID of the server.
+	UUID string `json:"uuid"`
+
+	// Name is the name of the server.
+	Name string `json:"name"`
+
+	// Hostname is the hostname of the server.
+	Hostname string `json:"hostname"`
+
+	// AvailabilityZone is the availability zone of the server.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+
+	// ProjectID is the project of the server.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// LaunchIndex is the index of the server among the servers created by
+	// the same request.
+	LaunchIndex int `json:"launch_index"`
+
+	// PublicKeys maps the names of the key pairs of the server to their
+	// public keys.
+	PublicKeys map[string]string `json:"public_keys,omitempty"`
+
+	// Keys are the key pairs of the server.
+	Keys []Key `json:"keys,omitempty"`
+
+	// Meta is the metadata of the server.
+	Meta map[string]string `json:"meta,omitempty"`
+
+	// RandomSeed is a base64-encoded random seed for the server.
+	RandomSeed string `json:"random_seed,omitempty"`
+}
+
+// Key is a key pair of MetaData.
+type Key struct {
+	// Name is the name of the key pair.
+	Name string `json:"name"`
+
+	// Type is the type of the key pair, "ssh" or "x509".
+	Type string `json:"type"`
+
+	// Data is the public key.
+	Data string `json:"data"`
+}
+
+// NetworkData is the content of the network_data.json file of the config
+// drive and of the metadata service.
+type NetworkData struct {
+	// Links are the network interfaces of the server.
+	Links []Link `json:"links"`
+
+	// Networks are the networks configured on Links.
+	Networks []Network `json:"networks"`
+
+	// Services are the network services available to the server.
+	Services []Service `json:"services"`
+}
+
+// Link is a network interface of NetworkData.
+type Link struct {
+	// ID identifies the link in Network.Link.
+	ID string `json:"id"`
+
+	// Type is the type of the link, such as "phy", "ovs" or "bond".
+	Type string `json:"type"`
+
+	// EthernetMACAddress is the MAC address of the link.
+	EthernetMACAddress string `json:"ethernet_mac_address"`
+
+	// MTU is the MTU of the link.
+	MTU int `json:"mtu,omitempty"`
+
+	// VIFID is the ID of the port of the link.
+	VIFID string `json:"vif_id,omitempty"`
+}
+
+// Network is a network of NetworkData.
+type Network struct {
+	// ID identifies the network.
+	ID string `json:"id"`
+
+	// Type is the type of the network, such as "ipv4", "ipv4_dhcp", "ipv6"
+	// or "ipv6_slaac".
+	Type string `json:"type"`
+
+	// Link is the ID of the Link the network is configured on.
+	Link string `json:"link"`
+
+	// IPAddress is the fixed IP of the server, for static networks.
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// Netmask is the netmask of the network, for static networks.
+	Netmask string `json:"netmask,omitempty"`
+
+	// NetworkID is the ID of the network in the Networking service.
+	NetworkID string `json:"network_id"`
+
+	// Routes are the routes of the network.
+	Routes []Route `json:"routes,omitempty"`
+
+	// Services are the network services available on the network.
+	Services []Service `json:"services,omitempty"`
+}
+
+// Route is a route of a Network.
+type Route struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway"`
+}
+
+// Service is a network service, such as a DNS server.
+type Service struct {
+	// Type is the type of the service, such as "dns".
+	Type string `json:"type"`
+
+	// Address is the address of the service.
+	Address string `json:"address"`
+}
+
+// ConfigDrive is the OpenStack content of a config drive, as the Compute
+// service renders it. It is meant to test the guest side of user data, such
+// as images or cloud-init configurations, without booting servers.
+type ConfigDrive struct {
+	MetaData MetaData
+
+	// NetworkData is omitted from the config drive if nil.
+	NetworkData *NetworkData
+
+	// UserData, as returned by Builder.Build, is omitted from the config drive
+	// if nil.
+	UserData []byte
+}
+
+// Files renders the config drive as a map of the paths of its files, such as
+// MetaDataPath, to their contents.
+func (d ConfigDrive) Files() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	metaData, err := json.Marshal(d.MetaData)
+	if err != nil {
+		return nil, err
+	}
+	files[MetaDataPath] = metaData
+
+	if d.NetworkData != nil {
+		// The Compute service renders empty lists, rather than nulls.
+		n := *d.NetworkData
+		if n.Links == nil {
+			n.Links = []Link{}
+		}
+		if n.Networks == nil {
+			n.Networks = []Network{}
+		}
+		if n.Services == nil {
+			n.Services = []Service{}
+		}
+
+		networkData, err := json.Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		files[NetworkDataPath] = networkData
+	}
+
+	if d.UserData != nil {
+		files[UserDataPath] = d.UserData
+	}
+
+	return files, nil
+}
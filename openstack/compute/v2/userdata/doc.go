@@ -0,0 +1,60 @@
+/*
+Package userdata composes the user data of servers, for cloud-init to process
+on their first boot, and renders the config drive files the Compute service
+provides to servers, to test images and user data without booting them.
+
+Example to Build User Data from a Cloud-Config Document and a Script
+
+	builder := userdata.Builder{Gzip: true}
+	builder.AddCloudConfig(map[string]interface{}{
+		"packages": []string{"nginx"},
+	})
+	builder.AddShellScript("#!/bin/sh\nsystemctl enable --now nginx\n")
+	builder.AddIncludeURLs("https://config.example.com/web.yaml")
+
+	userData, err := builder.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "web-1",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "2",
+		UserData:  userData,
+	}
+
+	server, err := servers.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Render a Config Drive
+
+	drive := userdata.ConfigDrive{
+		MetaData: userdata.MetaData{
+			UUID:     "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+			Name:     "web-1",
+			Hostname: "web-1",
+		},
+		NetworkData: &userdata.NetworkData{
+			Links: []userdata.Link{
+				{ID: "eth0", Type: "phy", EthernetMACAddress: "fa:16:3e:9c:bf:3d"},
+			},
+			Networks: []userdata.Network{
+				{ID: "network0", Type: "ipv4_dhcp", Link: "eth0"},
+			},
+		},
+		UserData: userData,
+	}
+
+	files, err := drive.Files()
+	if err != nil {
+		panic(err)
+	}
+
+	for path, content := range files {
+		os.WriteFile(filepath.Join(root, path), content, 0644)
+	}
+*/
+package userdata
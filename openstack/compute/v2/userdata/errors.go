@@ -0,0 +1,46 @@
+package userdata
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ErrTooLarge is the error when the user data exceeds the size accepted by
+// the Compute service once base64-encoded.
+type ErrTooLarge struct {
+	gophercloud.BaseError
+
+	// Size is the size of the base64-encoded user data.
+	Size int
+
+	// Limit is the maximum size of the base64-encoded user data.
+	Limit int
+}
+
+func (e ErrTooLarge) Error() string {
+	e.DefaultErrString = fmt.Sprintf("User data is %d bytes once base64-encoded, more than the limit of %d bytes", e.Size, e.Limit)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrInvalidPart is the error when a part of the user data is invalid.
+type ErrInvalidPart struct {
+	gophercloud.BaseError
+
+	// Index is the position of the part in the Builder.
+	Index int
+
+	// Reason describes why the part is invalid.
+	Reason string
+}
+
+func (e ErrInvalidPart) Error() string {
+	e.DefaultErrString = fmt.Sprintf("User data part %d is invalid: %s", e.Index, e.Reason)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
@@ -0,0 +1,132 @@
+package testing
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/userdata"
+)
+
+// CloudConfig is the cloud-config document of the user data.
+var CloudConfig = map[string]interface{}{
+	"packages": []string{"nginx"},
+	"runcmd":   []string{"systemctl enable --now nginx"},
+}
+
+// ShellScript is the script of the user data.
+const ShellScript = "#!/bin/sh\necho ready > /run/ready\n"
+
+// ExpectedCloudConfig is the rendering of CloudConfig.
+const ExpectedCloudConfig = `#cloud-config
+packages:
+- nginx
+runcmd:
+- systemctl enable --now nginx
+`
+
+// ExpectedMultipart is the rendering of CloudConfig, ShellScript and an
+// include URL with the "==BOUNDARY==" boundary.
+const ExpectedMultipart = "Content-Type: multipart/mixed; boundary=\"==BOUNDARY==\"\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"\r\n" +
+	"--==BOUNDARY==\r\n" +
+	"Content-Disposition: attachment; filename=\"part-001.txt\"\r\n" +
+	"Content-Type: text/cloud-config; charset=\"utf-8\"\r\n" +
+	"Mime-Version: 1.0\r\n" +
+	"\r\n" +
+	ExpectedCloudConfig +
+	"\r\n--==BOUNDARY==\r\n" +
+	"Content-Disposition: attachment; filename=\"part-002.txt\"\r\n" +
+	"Content-Type: text/x-shellscript; charset=\"utf-8\"\r\n" +
+	"Mime-Version: 1.0\r\n" +
+	"\r\n" +
+	ShellScript +
+	"\r\n--==BOUNDARY==\r\n" +
+	"Content-Disposition: attachment; filename=\"part-003.txt\"\r\n" +
+	"Content-Type: text/x-include-url; charset=\"utf-8\"\r\n" +
+	"Mime-Version: 1.0\r\n" +
+	"\r\n" +
+	"#include\nhttps://example.com/extra.yaml\n" +
+	"\r\n--==BOUNDARY==--\r\n"
+
+// ConfigDrive is a sample config drive.
+var ConfigDrive = userdata.ConfigDrive{
+	MetaData: userdata.MetaData{
+		UUID:             "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+		Name:             "web-1",
+		Hostname:         "web-1",
+		AvailabilityZone: "nova",
+		PublicKeys: map[string]string{
+			"deploy": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG9 deploy",
+		},
+		Keys: []userdata.Key{
+			{Name: "deploy", Type: "ssh", Data: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG9 deploy"},
+		},
+		Meta: map[string]string{"role": "web"},
+	},
+	NetworkData: &userdata.NetworkData{
+		Links: []userdata.Link{
+			{
+				ID:                 "tapd80b1a3b-4f",
+				Type:               "ovs",
+				EthernetMACAddress: "fa:16:3e:9c:bf:3d",
+				MTU:                1450,
+				VIFID:              "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+			},
+		},
+		Networks: []userdata.Network{
+			{
+				ID:        "network0",
+				Type:      "ipv4_dhcp",
+				Link:      "tapd80b1a3b-4f",
+				NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			},
+		},
+	},
+	UserData: []byte(ExpectedCloudConfig),
+}
+
+// ExpectedMetaData is the meta_data.json file of ConfigDrive.
+const ExpectedMetaData = `
+{
+    "uuid": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+    "name": "web-1",
+    "hostname": "web-1",
+    "availability_zone": "nova",
+    "launch_index": 0,
+    "public_keys": {
+        "deploy": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG9 deploy"
+    },
+    "keys": [
+        {
+            "name": "deploy",
+            "type": "ssh",
+            "data": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG9 deploy"
+        }
+    ],
+    "meta": {
+        "role": "web"
+    }
+}
+`
+
+// ExpectedNetworkData is the network_data.json file of ConfigDrive.
+const ExpectedNetworkData = `
+{
+    "links": [
+        {
+            "id": "tapd80b1a3b-4f",
+            "type": "ovs",
+            "ethernet_mac_address": "fa:16:3e:9c:bf:3d",
+            "mtu": 1450,
+            "vif_id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b"
+        }
+    ],
+    "networks": [
+        {
+            "id": "network0",
+            "type": "ipv4_dhcp",
+            "link": "tapd80b1a3b-4f",
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+        }
+    ],
+    "services": []
+}
+`
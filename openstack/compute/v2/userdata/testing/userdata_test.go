@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/servers"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/compute/v2/userdata"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestBuildSinglePart(t *testing.T) {
+	var b userdata.Builder
+	b.AddCloudConfig(CloudConfig)
+
+	actual, err := b.Build()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedCloudConfig, string(actual))
+
+	b = userdata.Builder{}
+	b.AddCloudConfig("packages:\n- nginx\nruncmd:\n- systemctl enable --now nginx\n")
+
+	actual, err = b.Build()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedCloudConfig, string(actual))
+}
+
+func TestBuildMultipart(t *testing.T) {
+	b := userdata.Builder{Boundary: "==BOUNDARY=="}
+	b.AddCloudConfig(CloudConfig)
+	b.AddShellScript(ShellScript)
+	b.AddIncludeURLs("https://example.com/extra.yaml")
+
+	actual, err := b.Build()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedMultipart, string(actual))
+
+	opts := servers.CreateOpts{
+		Name:     "web-1",
+		UserData: actual,
+	}
+	m, err := opts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	encoded := *m["server"].(map[string]interface{})["user_data"].(*string)
+	th.AssertEquals(t, base64.StdEncoding.EncodeToString(actual), encoded)
+}
+
+func TestBuildGzip(t *testing.T) {
+	b := userdata.Builder{Gzip: true}
+	b.AddShellScript(ShellScript)
+
+	actual, err := b.Build()
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(actual))
+	th.AssertNoErr(t, err)
+	decompressed, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ShellScript, string(decompressed))
+}
+
+func TestBuildTooLarge(t *testing.T) {
+	random := make([]byte, 48*1024)
+	_, err := rand.Read(random)
+	th.AssertNoErr(t, err)
+
+	var b userdata.Builder
+	b.AddShellScript("#!/bin/sh\n# " + base64.StdEncoding.EncodeToString(random))
+
+	_, err = b.Build()
+	tooLarge, ok := err.(userdata.ErrTooLarge)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, userdata.MaxSize, tooLarge.Limit)
+
+	// Compression brings the payload under the limit.
+	b = userdata.Builder{Gzip: true}
+	b.AddShellScript("#!/bin/sh\n" + string(bytes.Repeat([]byte("echo hello\n"), 10000)))
+	_, err = b.Build()
+	th.AssertNoErr(t, err)
+}
+
+func TestBuildInvalidPart(t *testing.T) {
+	var b userdata.Builder
+	b.AddCloudConfig(CloudConfig)
+	b.AddShellScript("echo missing shebang")
+
+	_, err := b.Build()
+	invalid, ok := err.(userdata.ErrInvalidPart)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 1, invalid.Index)
+	th.AssertEquals(t, "User data part 1 is invalid: shell script without a shebang", invalid.Error())
+
+	invalid.Info = "custom message"
+	th.AssertEquals(t, "custom message", invalid.Error())
+
+	_, err = new(userdata.Builder).Build()
+	if err == nil {
+		t.Fatal("Expected an error for a Builder without parts")
+	}
+}
+
+func TestConfigDriveFiles(t *testing.T) {
+	files, err := ConfigDrive.Files()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(files))
+
+	var metaData, networkData interface{}
+	th.AssertNoErr(t, json.Unmarshal(files[userdata.MetaDataPath], &metaData))
+	th.AssertNoErr(t, json.Unmarshal(files[userdata.NetworkDataPath], &networkData))
+	th.CheckJSONEquals(t, ExpectedMetaData, metaData)
+	th.CheckJSONEquals(t, ExpectedNetworkData, networkData)
+	th.AssertEquals(t, ExpectedCloudConfig, string(files[userdata.UserDataPath]))
+}
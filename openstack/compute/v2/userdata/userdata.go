@@ -0,0 +1,198 @@
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// MaxSize is the maximum size of the user data of a server, once
+// base64-encoded.
+const MaxSize = 65535
+
+// The content types of the parts of user data understood by cloud-init.
+const (
+	ContentTypeCloudConfig = "text/cloud-config"
+	ContentTypeShellScript = "text/x-shellscript"
+	ContentTypeIncludeURL  = "text/x-include-url"
+	ContentTypeBoothook    = "text/cloud-boothook"
+)
+
+// Part is a part of user data.
+type Part struct {
+	// ContentType is the MIME type of the part, such as
+	// ContentTypeCloudConfig.
+	ContentType string
+
+	// Filename is the name of the part. It defaults to "part-NNN.txt".
+	Filename string
+
+	// Content is the content of the part.
+	Content []byte
+}
+
+// Builder composes user data from cloud-config documents, scripts and include
+// URLs. Its zero value is ready to use.
+type Builder struct {
+	// Gzip compresses the user data, which cloud-init decompresses.
+	Gzip bool
+
+	// Boundary is the boundary of the MIME multipart payload. It is random
+	// if empty.
+	Boundary string
+
+	parts []part
+}
+
+// part is a Part of a Builder, whose content is marshaled from config, if
+// set, by Build.
+type part struct {
+	Part
+	config interface{}
+}
+
+// AddCloudConfig adds a cloud-config document. config is either the YAML
+// document, as a string or a []byte, with or without its "#cloud-config"
+// header, or a value to marshal as YAML, such as a map[string]interface{}.
+func (b *Builder) AddCloudConfig(config interface{}) {
+	switch v := config.(type) {
+	case string:
+		b.addCloudConfigText(v)
+	case []byte:
+		b.addCloudConfigText(string(v))
+	default:
+		b.parts = append(b.parts, part{Part: Part{ContentType: ContentTypeCloudConfig}, config: config})
+	}
+}
+
+func (b *Builder) addCloudConfigText(config string) {
+	if !strings.HasPrefix(config, "#cloud-config") {
+		config = "#cloud-config\n" + config
+	}
+	b.AddPart(Part{ContentType: ContentTypeCloudConfig, Content: []byte(config)})
+}
+
+// AddShellScript adds a script, run once on the first boot. It must start with
+// a shebang, such as "#!/bin/sh".
+func (b *Builder) AddShellScript(script string) {
+	b.AddPart(Part{ContentType: ContentTypeShellScript, Content: []byte(script)})
+}
+
+// AddIncludeURLs adds URLs of user data for cloud-init to fetch and process.
+func (b *Builder) AddIncludeURLs(urls ...string) {
+	content := "#include\n" + strings.Join(urls, "\n") + "\n"
+	b.AddPart(Part{ContentType: ContentTypeIncludeURL, Content: []byte(content)})
+}
+
+// AddPart adds a part of any content type.
+func (b *Builder) AddPart(p Part) {
+	b.parts = append(b.parts, part{Part: p})
+}
+
+/*
+Build returns the user data, to be set as the UserData of servers.CreateOpts
+or servers.RebuildOpts, which base64-encode it.
+
+A single part is returned as is, and several parts as a MIME multipart
+payload. The user data is then compressed if Gzip is set. An ErrTooLarge is
+returned if it exceeds MaxSize once base64-encoded.
+*/
+func (b *Builder) Build() ([]byte, error) {
+	if len(b.parts) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "userdata.Builder parts"
+		return nil, err
+	}
+
+	parts := make([]Part, len(b.parts))
+	for i, p := range b.parts {
+		part := p.Part
+		if p.config != nil {
+			content, err := yaml.Marshal(p.config)
+			if err != nil {
+				return nil, ErrInvalidPart{Index: i, Reason: err.Error()}
+			}
+			part.Content = append([]byte("#cloud-config\n"), content...)
+		}
+		if part.ContentType == "" {
+			return nil, ErrInvalidPart{Index: i, Reason: "missing content type"}
+		}
+		if part.ContentType == ContentTypeShellScript && !bytes.HasPrefix(part.Content, []byte("#!")) {
+			return nil, ErrInvalidPart{Index: i, Reason: "shell script without a shebang"}
+		}
+		if part.Filename == "" {
+			part.Filename = fmt.Sprintf("part-%03d.txt", i+1)
+		}
+		parts[i] = part
+	}
+
+	var payload []byte
+	if len(parts) == 1 {
+		payload = parts[0].Content
+	} else {
+		var err error
+		payload, err = b.multipart(parts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Gzip {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		payload = buf.Bytes()
+	}
+
+	if size := base64.StdEncoding.EncodedLen(len(payload)); size > MaxSize {
+		return nil, ErrTooLarge{Size: size, Limit: MaxSize}
+	}
+
+	return payload, nil
+}
+
+// multipart renders parts as a MIME multipart payload.
+func (b *Builder) multipart(parts []Part) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if b.Boundary != "" {
+		if err := w.SetBoundary(b.Boundary); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.ContentType+`; charset="utf-8"`)
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, part.Filename))
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write(part.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var payload bytes.Buffer
+	fmt.Fprintf(&payload, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", w.Boundary())
+	payload.Write(body.Bytes())
+	return payload.Bytes(), nil
+}
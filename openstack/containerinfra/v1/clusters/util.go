@@ -0,0 +1,40 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the
+// cluster to reach one of the target statuses. It fails as soon as an
+// operation on the cluster fails, with the reason of the status of the
+// cluster.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*Cluster] {
+	return &gophercloud.StateWaiter[*Cluster]{
+		Get: func(ctx context.Context) (*Cluster, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(cluster *Cluster) string {
+			return cluster.Status
+		},
+		Detail: func(cluster *Cluster) string {
+			return cluster.StatusReason
+		},
+		Target: target,
+		Failure: []string{
+			"CREATE_FAILED", "UPDATE_FAILED", "DELETE_FAILED", "RESUME_FAILED", "RESTORE_FAILED",
+			"ROLLBACK_FAILED", "SNAPSHOT_FAILED", "CHECK_FAILED", "ADOPT_FAILED",
+		},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the
+// cluster to be deleted. Clusters which failed to be created or updated can be
+// deleted, so it fails on DELETE_FAILED only.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Cluster] {
+	w := NewStatusWaiter(c, id, "DELETE_COMPLETE")
+	w.Failure = []string{"DELETE_FAILED"}
+	w.NotFoundIsTarget = true
+	return w
+}
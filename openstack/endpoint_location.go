@@ -1,6 +1,9 @@
 package openstack
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/yogeshwargnanasekaran/gophercloud"
 	tokens2 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v2/tokens"
 	tokens3 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
@@ -17,16 +20,28 @@ will also often need to specify a Name and/or a Region depending on what's
 available on your OpenStack deployment.
 */
 func V2EndpointURL(catalog *tokens2.ServiceCatalog, opts gophercloud.EndpointOpts) (string, error) {
-	// Extract Endpoints from the catalog entries that match the requested Type, Name if provided, and Region if provided.
+	if url, ok := endpointOverride(opts); ok {
+		return url, nil
+	}
+
+	// Extract Endpoints from the catalog entries that match the requested Type,
+	// Name if provided, and Region if provided. The aliases of Type are only
+	// searched if Type itself is not found.
+	types := ServiceTypeAliases(opts.Type)
 	var endpoints = make([]tokens2.Endpoint, 0, 1)
-	for _, entry := range catalog.Entries {
-		if (entry.Type == opts.Type) && (opts.Name == "" || entry.Name == opts.Name) {
-			for _, endpoint := range entry.Endpoints {
-				if opts.Region == "" || endpoint.Region == opts.Region {
-					endpoints = append(endpoints, endpoint)
+	for _, t := range types {
+		for _, entry := range catalog.Entries {
+			if (entry.Type == t) && (opts.Name == "" || entry.Name == opts.Name) {
+				for _, endpoint := range entry.Endpoints {
+					if opts.Region == "" || endpoint.Region == opts.Region {
+						endpoints = append(endpoints, endpoint)
+					}
 				}
 			}
 		}
+		if len(endpoints) > 0 {
+			break
+		}
 	}
 
 	// If multiple endpoints were found, use the first result
@@ -55,7 +70,19 @@ func V2EndpointURL(catalog *tokens2.ServiceCatalog, opts gophercloud.EndpointOpt
 	}
 
 	// Report an error if there were no matching endpoints.
-	err := &gophercloud.ErrEndpointNotFound{}
+	err := &gophercloud.ErrEndpointNotFound{
+		Types:        types,
+		Name:         opts.Name,
+		Region:       opts.Region,
+		Availability: opts.Availability,
+	}
+	for _, entry := range catalog.Entries {
+		regions := make([]string, 0, len(entry.Endpoints))
+		for _, endpoint := range entry.Endpoints {
+			regions = append(regions, endpoint.Region)
+		}
+		err.Catalog = append(err.Catalog, describeCatalogEntry(entry.Type, entry.Name, regions))
+	}
 	return "", err
 }
 
@@ -70,26 +97,37 @@ will also often need to specify a Name and/or a Region depending on what's
 available on your OpenStack deployment.
 */
 func V3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpts) (string, error) {
+	if url, ok := endpointOverride(opts); ok {
+		return url, nil
+	}
+
 	// Extract Endpoints from the catalog entries that match the requested Type, Interface,
-	// Name if provided, and Region if provided.
+	// Name if provided, and Region if provided. The aliases of Type are only
+	// searched if Type itself is not found.
+	types := ServiceTypeAliases(opts.Type)
 	var endpoints = make([]tokens3.Endpoint, 0, 1)
-	for _, entry := range catalog.Entries {
-		if (entry.Type == opts.Type) && (opts.Name == "" || entry.Name == opts.Name) {
-			for _, endpoint := range entry.Endpoints {
-				if opts.Availability != gophercloud.AvailabilityAdmin &&
-					opts.Availability != gophercloud.AvailabilityPublic &&
-					opts.Availability != gophercloud.AvailabilityInternal {
-					err := &ErrInvalidAvailabilityProvided{}
-					err.Argument = "Availability"
-					err.Value = opts.Availability
-					return "", err
-				}
-				if (opts.Availability == gophercloud.Availability(endpoint.Interface)) &&
-					(opts.Region == "" || endpoint.Region == opts.Region || endpoint.RegionID == opts.Region) {
-					endpoints = append(endpoints, endpoint)
+	for _, t := range types {
+		for _, entry := range catalog.Entries {
+			if (entry.Type == t) && (opts.Name == "" || entry.Name == opts.Name) {
+				for _, endpoint := range entry.Endpoints {
+					if opts.Availability != gophercloud.AvailabilityAdmin &&
+						opts.Availability != gophercloud.AvailabilityPublic &&
+						opts.Availability != gophercloud.AvailabilityInternal {
+						err := &ErrInvalidAvailabilityProvided{}
+						err.Argument = "Availability"
+						err.Value = opts.Availability
+						return "", err
+					}
+					if (opts.Availability == gophercloud.Availability(endpoint.Interface)) &&
+						(opts.Region == "" || endpoint.Region == opts.Region || endpoint.RegionID == opts.Region) {
+						endpoints = append(endpoints, endpoint)
+					}
 				}
 			}
 		}
+		if len(endpoints) > 0 {
+			break
+		}
 	}
 
 	// If multiple endpoints were found, use the first result
@@ -106,6 +144,46 @@ func V3EndpointURL(catalog *tokens3.ServiceCatalog, opts gophercloud.EndpointOpt
 	}
 
 	// Report an error if there were no matching endpoints.
-	err := &gophercloud.ErrEndpointNotFound{}
+	err := &gophercloud.ErrEndpointNotFound{
+		Types:        types,
+		Name:         opts.Name,
+		Region:       opts.Region,
+		Availability: opts.Availability,
+	}
+	for _, entry := range catalog.Entries {
+		regions := make([]string, 0, len(entry.Endpoints))
+		for _, endpoint := range entry.Endpoints {
+			region := endpoint.Region
+			if region == "" {
+				region = endpoint.RegionID
+			}
+			regions = append(regions, region+" "+endpoint.Interface)
+		}
+		err.Catalog = append(err.Catalog, describeCatalogEntry(entry.Type, entry.Name, regions))
+	}
 	return "", err
 }
+
+// describeCatalogEntry describes a service catalog entry for
+// ErrEndpointNotFound, listing each distinct location once.
+func describeCatalogEntry(serviceType, name string, locations []string) string {
+	desc := serviceType
+	if name != "" {
+		desc += fmt.Sprintf(" %q", name)
+	}
+
+	seen := make(map[string]bool, len(locations))
+	var distinct []string
+	for _, l := range locations {
+		l = strings.TrimSpace(l)
+		if l == "" || seen[l] {
+			continue
+		}
+		seen[l] = true
+		distinct = append(distinct, l)
+	}
+	if len(distinct) > 0 {
+		desc += " (" + strings.Join(distinct, ", ") + ")"
+	}
+	return desc
+}
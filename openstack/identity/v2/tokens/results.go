@@ -55,7 +55,9 @@ type User struct {
 // combined will assume a zero-value ("").
 type Endpoint struct {
 	TenantID    string `json:"tenantId"`
+	PublicURL   string `json:"publicURL"`
 	InternalURL string `json:"internalURL"`
+	AdminURL    string `json:"adminURL"`
 	Region      string `json:"region"`
 	VersionID   string `json:"versionId"`
 	VersionInfo string `json:"versionInfo"`
@@ -148,6 +150,16 @@ func (r CreateResult) ExtractTokenID() (string, error) {
 	return s.Access.Token.ID, err
 }
 
+// ExtractExpiresAt returns the time at which the token expires. It allows
+// gophercloud.ProviderClient to refresh the token before it expires.
+func (r CreateResult) ExtractExpiresAt() (time.Time, error) {
+	token, err := r.ExtractToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return token.ExpiresAt, nil
+}
+
 // ExtractServiceCatalog returns the ServiceCatalog that was generated along
 // with the user's Token.
 func (r CreateResult) ExtractServiceCatalog() (*ServiceCatalog, error) {
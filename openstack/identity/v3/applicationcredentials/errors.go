@@ -0,0 +1,40 @@
+package applicationcredentials
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ErrRotationVerificationFailed is returned by Rotate when the new
+// application credential could not be verified. The rotated application
+// credential is left untouched.
+type ErrRotationVerificationFailed struct {
+	gophercloud.BaseError
+
+	// ID is the ID of the new application credential.
+	ID string
+
+	// Err is the error returned by the verification.
+	Err error
+
+	// DeleteErr is the error that occurred deleting the new application
+	// credential, if any.
+	DeleteErr error
+}
+
+func (e ErrRotationVerificationFailed) Error() string {
+	if e.DeleteErr != nil {
+		e.DefaultErrString = fmt.Sprintf("Unable to verify application credential %s: %s; it could not be deleted: %s", e.ID, e.Err, e.DeleteErr)
+	} else {
+		e.DefaultErrString = fmt.Sprintf("Unable to verify application credential %s: %s", e.ID, e.Err)
+	}
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+func (e ErrRotationVerificationFailed) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,113 @@
+package applicationcredentials
+
+import (
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ExpiresWithin reports whether the application credential expires within d.
+// Application credentials without an expiration time never expire.
+func (r ApplicationCredential) ExpiresWithin(d time.Duration) bool {
+	if r.ExpiresAt.IsZero() {
+		return false
+	}
+	return time.Until(r.ExpiresAt) <= d
+}
+
+// RotateOpts provides options used to rotate an application credential.
+type RotateOpts struct {
+	// Name is the name of the new application credential. The names of the
+	// application credentials of a user are unique, so it must differ from the
+	// name of the rotated application credential.
+	Name string
+
+	// Description is the description of the new application credential. It
+	// defaults to the description of the rotated application credential.
+	Description string
+
+	// Secret is the secret of the new application credential. It is generated
+	// by the server if empty.
+	Secret string
+
+	// ExpiresAt is the expiration time of the new application credential.
+	ExpiresAt *time.Time
+
+	// Verify checks that the new application credential is usable, before the
+	// rotated application credential is deleted. It is required. It typically
+	// authenticates with the new application credential:
+	//
+	//	Verify: func(ac *applicationcredentials.ApplicationCredential) error {
+	//		provider, err := openstack.NewClient(identityEndpoint)
+	//		if err != nil {
+	//			return err
+	//		}
+	//		return openstack.AuthenticateV3(provider, &tokens.AuthOptions{
+	//			ApplicationCredentialID:     ac.ID,
+	//			ApplicationCredentialSecret: ac.Secret,
+	//		}, gophercloud.EndpointOpts{})
+	//	},
+	Verify func(*ApplicationCredential) error
+}
+
+// Rotate replaces an application credential of a user by a new application
+// credential with the same roles, access rules and restriction. The rotated
+// application credential is deleted only once the new one is verified, so a
+// failed rotation leaves the rotated application credential usable.
+//
+// If the verification fails, the new application credential is deleted and
+// an ErrRotationVerificationFailed is returned. If the rotated application
+// credential cannot be deleted, the new application credential is returned
+// along with the error, since its secret cannot be retrieved again.
+//
+// As for Create, the client must be authenticated as the user, and not with
+// an application credential unless it is unrestricted.
+func Rotate(client *gophercloud.ServiceClient, userID string, id string, opts RotateOpts) (*ApplicationCredential, error) {
+	if opts.Name == "" {
+		return nil, gophercloud.ErrMissingInput{Argument: "Name"}
+	}
+	if opts.Verify == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "Verify"}
+	}
+
+	old, err := Get(client, userID, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := CreateOpts{
+		Name:         opts.Name,
+		Description:  opts.Description,
+		Unrestricted: old.Unrestricted,
+		Secret:       opts.Secret,
+		ExpiresAt:    opts.ExpiresAt,
+	}
+	if createOpts.Description == "" {
+		createOpts.Description = old.Description
+	}
+	for _, role := range old.Roles {
+		createOpts.Roles = append(createOpts.Roles, Role{ID: role.ID})
+	}
+	for _, rule := range old.AccessRules {
+		createOpts.AccessRules = append(createOpts.AccessRules, AccessRule{ID: rule.ID})
+	}
+
+	ac, err := Create(client, userID, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := opts.Verify(ac); err != nil {
+		return nil, ErrRotationVerificationFailed{
+			ID:        ac.ID,
+			Err:       err,
+			DeleteErr: Delete(client, userID, ac.ID).ExtractErr(),
+		}
+	}
+
+	if err := Delete(client, userID, id).ExtractErr(); err != nil {
+		return ac, err
+	}
+
+	return ac, nil
+}
@@ -519,3 +519,139 @@ func HandleDeleteAccessRuleSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// RotateCreateRequest provides the input to the Create request of a rotation.
+const RotateCreateRequest = `
+{
+  "application_credential": {
+    "name": "test-rotated",
+    "unrestricted": false,
+    "roles": [
+      {
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+      }
+    ],
+    "access_rules": [
+      {
+        "id": "07d719df00f349ef8de77d542edf010c"
+      }
+    ]
+  }
+}
+`
+
+// RotateCreateResponse provides the output of the Create request of a rotation.
+const RotateCreateResponse = `
+{
+  "application_credential": {
+    "links": {
+      "self": "https://identity/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d"
+    },
+    "description": null,
+    "roles": [
+      {
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13",
+        "domain_id": null,
+        "name": "compute_viewer"
+      }
+    ],
+    "access_rules": [
+      {
+        "path": "/v2.0/metrics",
+        "id": "07d719df00f349ef8de77d542edf010c",
+        "service": "monitoring",
+        "method": "GET"
+      }
+    ],
+    "expires_at": null,
+    "secret": "rotatedsecret",
+    "unrestricted": false,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "id": "0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d",
+    "name": "test-rotated"
+  }
+}
+`
+
+// RotateAuthRequest provides the authentication request with the rotated
+// application credential.
+const RotateAuthRequest = `
+{
+  "auth": {
+    "identity": {
+      "application_credential": {
+        "id": "0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d",
+        "secret": "rotatedsecret"
+      },
+      "methods": ["application_credential"]
+    }
+  }
+}
+`
+
+// HandleRotateApplicationCredentialSuccessfully creates HTTP handlers on the
+// test handler mux for the rotation of an application credential. The
+// rotated application credential is expected to be deleted.
+func HandleRotateApplicationCredentialSuccessfully(t *testing.T) (deleted *[]string) {
+	return handleRotateApplicationCredential(t, http.StatusCreated)
+}
+
+// HandleRotateApplicationCredentialVerificationFailure creates HTTP handlers
+// on the test handler mux for the rotation of an application credential whose
+// successor cannot authenticate. The new application credential is expected to
+// be deleted instead of the rotated one.
+func HandleRotateApplicationCredentialVerificationFailure(t *testing.T) (deleted *[]string) {
+	return handleRotateApplicationCredential(t, http.StatusUnauthorized)
+}
+
+func handleRotateApplicationCredential(t *testing.T, authStatus int) (deleted *[]string) {
+	deleted = new([]string)
+
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/f741662395b249c9b8acdebf1722c5ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, GetOutput)
+		case "DELETE":
+			*deleted = append(*deleted, applicationCredentialID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, RotateCreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, RotateCreateResponse)
+	})
+
+	th.Mux.HandleFunc("/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		*deleted = append(*deleted, "0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, RotateAuthRequest)
+
+		if authStatus != http.StatusCreated {
+			w.WriteHeader(authStatus)
+			return
+		}
+		w.Header().Set("X-Subject-Token", "0123456789")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "2030-01-01T00:00:00.000000Z", "catalog": []}}`)
+	})
+
+	return deleted
+}
@@ -1,9 +1,14 @@
 package testing
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
 	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
 	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
 	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
@@ -168,3 +173,89 @@ func TestDeleteAccessRule(t *testing.T) {
 	res := applicationcredentials.DeleteAccessRule(client.ServiceClient(), userID, accessRuleID)
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestRotateApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	deleted := HandleRotateApplicationCredentialSuccessfully(t)
+
+	sc := client.ServiceClient()
+	sc.IdentityBase = th.Endpoint()
+
+	actual, err := applicationcredentials.Rotate(sc, userID, applicationCredentialID, applicationcredentials.RotateOpts{
+		Name:   "test-rotated",
+		Verify: authenticate(sc),
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d", actual.ID)
+	th.AssertEquals(t, "rotatedsecret", actual.Secret)
+	th.CheckDeepEquals(t, []string{applicationCredentialID}, *deleted)
+}
+
+func TestRotateApplicationCredentialVerificationFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	deleted := HandleRotateApplicationCredentialVerificationFailure(t)
+
+	sc := client.ServiceClient()
+	sc.IdentityBase = th.Endpoint()
+
+	_, err := applicationcredentials.Rotate(sc, userID, applicationCredentialID, applicationcredentials.RotateOpts{
+		Name:   "test-rotated",
+		Verify: authenticate(sc),
+	})
+	var verificationErr applicationcredentials.ErrRotationVerificationFailed
+	th.AssertEquals(t, true, errors.As(err, &verificationErr))
+	th.AssertEquals(t, "0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d", verificationErr.ID)
+	th.AssertNoErr(t, verificationErr.DeleteErr)
+	th.CheckDeepEquals(t, []string{"0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d"}, *deleted)
+}
+
+func TestRotateApplicationCredentialCustomVerify(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	deleted := HandleRotateApplicationCredentialSuccessfully(t)
+
+	verifyErr := errors.New("not yet")
+	_, err := applicationcredentials.Rotate(client.ServiceClient(), userID, applicationCredentialID, applicationcredentials.RotateOpts{
+		Name: "test-rotated",
+		Verify: func(ac *applicationcredentials.ApplicationCredential) error {
+			th.AssertEquals(t, "rotatedsecret", ac.Secret)
+			return verifyErr
+		},
+	})
+	th.AssertEquals(t, true, errors.Is(err, verifyErr))
+	th.CheckDeepEquals(t, []string{"0f9fcd5a8cba4f3a9e3d4b7a2f5b6c1d"}, *deleted)
+
+	_, err = applicationcredentials.Rotate(client.ServiceClient(), userID, applicationCredentialID, applicationcredentials.RotateOpts{})
+	th.AssertEquals(t, "Missing input for argument [Name]", err.Error())
+
+	_, err = applicationcredentials.Rotate(client.ServiceClient(), userID, applicationCredentialID, applicationcredentials.RotateOpts{
+		Name: "test-rotated",
+	})
+	th.AssertEquals(t, "Missing input for argument [Verify]", err.Error())
+}
+
+// authenticate returns a RotateOpts.Verify function that obtains a token with
+// the new application credential from the identity endpoint of client.
+func authenticate(client *gophercloud.ServiceClient) func(*applicationcredentials.ApplicationCredential) error {
+	return func(ac *applicationcredentials.ApplicationCredential) error {
+		provider, err := openstack.NewClient(client.IdentityBase)
+		if err != nil {
+			return err
+		}
+		return openstack.AuthenticateV3(provider, &tokens.AuthOptions{
+			ApplicationCredentialID:     ac.ID,
+			ApplicationCredentialSecret: ac.Secret,
+		}, gophercloud.EndpointOpts{})
+	}
+}
+
+func TestApplicationCredentialExpiresWithin(t *testing.T) {
+	var ac applicationcredentials.ApplicationCredential
+	th.AssertEquals(t, false, ac.ExpiresWithin(time.Hour))
+
+	ac.ExpiresAt = time.Now().Add(30 * time.Minute)
+	th.AssertEquals(t, true, ac.ExpiresWithin(time.Hour))
+	th.AssertEquals(t, false, ac.ExpiresWithin(time.Minute))
+}
@@ -0,0 +1,21 @@
+/*
+Package catalog lists the service catalog of the token of the client, without
+requesting a new token.
+
+Example to List the Service Catalog
+
+	allPages, err := catalog.List(identityClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allEntries, err := catalog.ExtractServiceCatalog(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range allEntries {
+		fmt.Printf("%s: %d endpoints\n", entry.Type, len(entry.Endpoints))
+	}
+*/
+package catalog
@@ -0,0 +1,15 @@
+package catalog
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// List enumerates the services and endpoints of the service catalog of the
+// token of the client.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	url := listURL(client)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceCatalogPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
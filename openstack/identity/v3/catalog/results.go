@@ -0,0 +1,27 @@
+package catalog
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ServiceCatalogPage is a single page of service catalog entries.
+type ServiceCatalogPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the ServiceCatalogPage contains no results.
+func (r ServiceCatalogPage) IsEmpty() (bool, error) {
+	entries, err := ExtractServiceCatalog(r)
+	return len(entries) == 0, err
+}
+
+// ExtractServiceCatalog extracts a slice of CatalogEntries from a page
+// acquired from List.
+func ExtractServiceCatalog(r pagination.Page) ([]tokens.CatalogEntry, error) {
+	var s struct {
+		Entries []tokens.CatalogEntry `json:"catalog"`
+	}
+	err := (r.(ServiceCatalogPage)).ExtractInto(&s)
+	return s.Entries, err
+}
@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of service catalog entries.
+const ListOutput = `
+{
+    "catalog": [
+        {
+            "endpoints": [
+                {
+                    "id": "39dc322ce86c4111b4f06c2eeae0841b",
+                    "interface": "public",
+                    "region": "RegionOne",
+                    "region_id": "RegionOne",
+                    "url": "http://localhost:5000"
+                },
+                {
+                    "id": "ec642f27474842e78bf059f6c48f4e99",
+                    "interface": "internal",
+                    "region": "RegionOne",
+                    "region_id": "RegionOne",
+                    "url": "http://localhost:5000"
+                }
+            ],
+            "id": "4363ae44bdf34a3981fde3b823cb9aa2",
+            "type": "identity",
+            "name": "keystone"
+        }
+    ],
+    "links": {
+        "self": "https://example.com/identity/v3/auth/catalog",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// ExpectedCatalogSlice is the slice of entries expected to be returned from
+// ListOutput.
+var ExpectedCatalogSlice = []tokens.CatalogEntry{
+	{
+		ID:   "4363ae44bdf34a3981fde3b823cb9aa2",
+		Name: "keystone",
+		Type: "identity",
+		Endpoints: []tokens.Endpoint{
+			{
+				ID:        "39dc322ce86c4111b4f06c2eeae0841b",
+				Interface: "public",
+				Region:    "RegionOne",
+				RegionID:  "RegionOne",
+				URL:       "http://localhost:5000",
+			},
+			{
+				ID:        "ec642f27474842e78bf059f6c48f4e99",
+				Interface: "internal",
+				Region:    "RegionOne",
+				RegionID:  "RegionOne",
+				URL:       "http://localhost:5000",
+			},
+		},
+	},
+}
+
+// HandleListCatalogSuccessfully creates an HTTP handler at `/auth/catalog`
+// on the test handler mux that responds with the service catalog.
+func HandleListCatalogSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/catalog", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
@@ -0,0 +1,22 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/catalog"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestListCatalog(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListCatalogSuccessfully(t)
+
+	allPages, err := catalog.List(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := catalog.ExtractServiceCatalog(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedCatalogSlice, actual)
+}
@@ -0,0 +1,7 @@
+package catalog
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("auth", "catalog")
+}
@@ -136,6 +136,21 @@ func HandleListDomainsSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleListAvailableDomainsSuccessfully creates an HTTP handler at
+// `/auth/domains` on the test handler mux that responds with a list of two
+// domains.
+func HandleListAvailableDomainsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/domains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
 // HandleGetDomainSuccessfully creates an HTTP handler at `/domains` on the
 // test handler mux that responds with a single domain.
 func HandleGetDomainSuccessfully(t *testing.T) {
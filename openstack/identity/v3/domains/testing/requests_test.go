@@ -29,6 +29,19 @@ func TestListDomains(t *testing.T) {
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListAvailableDomains(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAvailableDomainsSuccessfully(t)
+
+	allPages, err := domains.ListAvailable(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := domains.ExtractDomains(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedDomainsSlice, actual)
+}
+
 func TestListDomainsAllPages(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -6,6 +6,10 @@ func listURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("domains")
 }
 
+func listAvailableURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("auth", "domains")
+}
+
 func getURL(client *gophercloud.ServiceClient, domainID string) string {
 	return client.ServiceURL("domains", domainID)
 }
@@ -0,0 +1,45 @@
+/*
+Package endpointfilter manages the OS-EP-FILTER extension of the OpenStack
+Identity service, which restricts the service catalog of the tokens scoped to
+a project to the endpoints associated with the project, directly or through
+endpoint groups.
+
+Example to Create an Endpoint Group
+
+	createOpts := endpointfilter.CreateEndpointGroupOpts{
+		Name: "public compute",
+		Filters: endpointfilter.Filters{
+			Interface: gophercloud.AvailabilityPublic,
+			ServiceID: "1b501a",
+		},
+	}
+
+	endpointGroup, err := endpointfilter.CreateEndpointGroup(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate an Endpoint Group with a Project
+
+	err := endpointfilter.AddEndpointGroupToProject(identityClient, "ac4861", "263fd9").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Endpoints a Project Sees
+
+	allPages, err := endpointfilter.ListProjectEndpoints(identityClient, "263fd9").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allEndpoints, err := endpoints.ExtractEndpoints(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, endpoint := range allEndpoints {
+		fmt.Printf("%+v\n", endpoint)
+	}
+*/
+package endpointfilter
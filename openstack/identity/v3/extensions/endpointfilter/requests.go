@@ -0,0 +1,245 @@
+package endpointfilter
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/projects"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ListEndpointGroupsOptsBuilder allows extensions to add additional
+// parameters to the ListEndpointGroups request.
+type ListEndpointGroupsOptsBuilder interface {
+	ToEndpointGroupListQuery() (string, error)
+}
+
+// ListEndpointGroupsOpts provides options to filter the ListEndpointGroups
+// results.
+type ListEndpointGroupsOpts struct {
+	// Name filters the response by an endpoint group name.
+	Name string `q:"name"`
+}
+
+// ToEndpointGroupListQuery formats a ListEndpointGroupsOpts into a query
+// string.
+func (opts ListEndpointGroupsOpts) ToEndpointGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListEndpointGroups enumerates the endpoint groups.
+func ListEndpointGroups(client *gophercloud.ServiceClient, opts ListEndpointGroupsOptsBuilder) pagination.Pager {
+	url := endpointGroupsURL(client)
+	if opts != nil {
+		query, err := opts.ToEndpointGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return EndpointGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateEndpointGroupOptsBuilder allows extensions to add additional
+// parameters to the CreateEndpointGroup request.
+type CreateEndpointGroupOptsBuilder interface {
+	ToEndpointGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateEndpointGroupOpts provides options used to create an endpoint group.
+type CreateEndpointGroupOpts struct {
+	// Name is the name of the endpoint group.
+	Name string `json:"name" required:"true"`
+
+	// Description is the description of the endpoint group.
+	Description string `json:"description,omitempty"`
+
+	// Filters select the endpoints of the endpoint group.
+	Filters Filters `json:"filters" required:"true"`
+}
+
+// ToEndpointGroupCreateMap formats a CreateEndpointGroupOpts into a create
+// request.
+func (opts CreateEndpointGroupOpts) ToEndpointGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint_group")
+}
+
+// CreateEndpointGroup creates an endpoint group.
+func CreateEndpointGroup(client *gophercloud.ServiceClient, opts CreateEndpointGroupOptsBuilder) (r CreateEndpointGroupResult) {
+	b, err := opts.ToEndpointGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(endpointGroupsURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetEndpointGroup retrieves details on an endpoint group.
+func GetEndpointGroup(client *gophercloud.ServiceClient, endpointGroupID string) (r GetEndpointGroupResult) {
+	resp, err := client.Get(endpointGroupURL(client, endpointGroupID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateEndpointGroupOptsBuilder allows extensions to add additional
+// parameters to the UpdateEndpointGroup request.
+type UpdateEndpointGroupOptsBuilder interface {
+	ToEndpointGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateEndpointGroupOpts provides options used to update an endpoint group.
+type UpdateEndpointGroupOpts struct {
+	// Name is the name of the endpoint group.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the endpoint group.
+	Description *string `json:"description,omitempty"`
+
+	// Filters select the endpoints of the endpoint group.
+	Filters *Filters `json:"filters,omitempty"`
+}
+
+// ToEndpointGroupUpdateMap formats an UpdateEndpointGroupOpts into an update
+// request.
+func (opts UpdateEndpointGroupOpts) ToEndpointGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint_group")
+}
+
+// UpdateEndpointGroup updates an endpoint group.
+func UpdateEndpointGroup(client *gophercloud.ServiceClient, endpointGroupID string, opts UpdateEndpointGroupOptsBuilder) (r UpdateEndpointGroupResult) {
+	b, err := opts.ToEndpointGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(endpointGroupURL(client, endpointGroupID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteEndpointGroup deletes an endpoint group.
+func DeleteEndpointGroup(client *gophercloud.ServiceClient, endpointGroupID string) (r DeleteResult) {
+	resp, err := client.Delete(endpointGroupURL(client, endpointGroupID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListEndpointGroupEndpoints enumerates the endpoints an endpoint group
+// selects. Use endpoints.ExtractEndpoints to interpret the pages.
+func ListEndpointGroupEndpoints(client *gophercloud.ServiceClient, endpointGroupID string) pagination.Pager {
+	url := endpointGroupEndpointsURL(client, endpointGroupID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return endpoints.EndpointPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AddEndpointGroupToProject associates an endpoint group with a project: the
+// project sees the endpoints of the endpoint group.
+func AddEndpointGroupToProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (r AssociationResult) {
+	resp, err := client.Put(endpointGroupProjectURL(client, endpointGroupID, projectID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckEndpointGroupInProject checks whether an endpoint group is associated
+// with a project.
+func CheckEndpointGroupInProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (r CheckAssociationResult) {
+	resp, err := client.Head(endpointGroupProjectURL(client, endpointGroupID, projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204, 404},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err == nil {
+		r.isAssociated = resp.StatusCode != 404
+	}
+	return
+}
+
+// RemoveEndpointGroupFromProject removes the association of an endpoint
+// group with a project.
+func RemoveEndpointGroupFromProject(client *gophercloud.ServiceClient, endpointGroupID, projectID string) (r AssociationResult) {
+	resp, err := client.Delete(endpointGroupProjectURL(client, endpointGroupID, projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListEndpointGroupProjects enumerates the projects an endpoint group is
+// associated with. Use projects.ExtractProjects to interpret the pages.
+func ListEndpointGroupProjects(client *gophercloud.ServiceClient, endpointGroupID string) pagination.Pager {
+	url := endpointGroupProjectsURL(client, endpointGroupID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListProjectEndpointGroups enumerates the endpoint groups associated with a
+// project.
+func ListProjectEndpointGroups(client *gophercloud.ServiceClient, projectID string) pagination.Pager {
+	url := projectEndpointGroupsURL(client, projectID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return EndpointGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AddEndpointToProject associates an endpoint with a project: the project
+// sees the endpoint.
+func AddEndpointToProject(client *gophercloud.ServiceClient, projectID, endpointID string) (r AssociationResult) {
+	resp, err := client.Put(projectEndpointURL(client, projectID, endpointID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckEndpointInProject checks whether an endpoint is associated with a
+// project.
+func CheckEndpointInProject(client *gophercloud.ServiceClient, projectID, endpointID string) (r CheckAssociationResult) {
+	resp, err := client.Head(projectEndpointURL(client, projectID, endpointID), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204, 404},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err == nil {
+		r.isAssociated = resp.StatusCode != 404
+	}
+	return
+}
+
+// RemoveEndpointFromProject removes the association of an endpoint with a
+// project.
+func RemoveEndpointFromProject(client *gophercloud.ServiceClient, projectID, endpointID string) (r AssociationResult) {
+	resp, err := client.Delete(projectEndpointURL(client, projectID, endpointID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListProjectEndpoints enumerates the endpoints a project sees, whether they
+// are associated with the project directly or through an endpoint group. Use
+// endpoints.ExtractEndpoints to interpret the pages.
+func ListProjectEndpoints(client *gophercloud.ServiceClient, projectID string) pagination.Pager {
+	url := projectEndpointsURL(client, projectID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return endpoints.EndpointPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListEndpointProjects enumerates the projects an endpoint is associated
+// with. Use projects.ExtractProjects to interpret the pages.
+func ListEndpointProjects(client *gophercloud.ServiceClient, endpointID string) pagination.Pager {
+	url := endpointProjectsURL(client, endpointID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
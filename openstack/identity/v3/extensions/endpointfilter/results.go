@@ -0,0 +1,132 @@
+package endpointfilter
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// Filters select the endpoints of an endpoint group. An endpoint is selected
+// if it matches all the filters that are set.
+type Filters struct {
+	// Interface selects the endpoints of an interface.
+	Interface gophercloud.Availability `json:"interface,omitempty"`
+
+	// ServiceID selects the endpoints of a service.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// RegionID selects the endpoints of a region.
+	RegionID string `json:"region_id,omitempty"`
+}
+
+// EndpointGroup is a group of endpoints, selected by filters, which can be
+// associated with projects.
+type EndpointGroup struct {
+	// ID is the unique ID of the endpoint group.
+	ID string `json:"id"`
+
+	// Name is the name of the endpoint group.
+	Name string `json:"name"`
+
+	// Description is the description of the endpoint group.
+	Description string `json:"description"`
+
+	// Filters select the endpoints of the endpoint group.
+	Filters Filters `json:"filters"`
+
+	// Links contains referencing links to the endpoint group.
+	Links map[string]interface{} `json:"links"`
+}
+
+type endpointGroupResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any endpointGroupResult as an EndpointGroup.
+func (r endpointGroupResult) Extract() (*EndpointGroup, error) {
+	var s struct {
+		EndpointGroup *EndpointGroup `json:"endpoint_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.EndpointGroup, err
+}
+
+// CreateEndpointGroupResult is the response from a CreateEndpointGroup
+// operation. Call its Extract method to interpret it as an EndpointGroup.
+type CreateEndpointGroupResult struct {
+	endpointGroupResult
+}
+
+// GetEndpointGroupResult is the response from a GetEndpointGroup operation.
+// Call its Extract method to interpret it as an EndpointGroup.
+type GetEndpointGroupResult struct {
+	endpointGroupResult
+}
+
+// UpdateEndpointGroupResult is the response from an UpdateEndpointGroup
+// operation. Call its Extract method to interpret it as an EndpointGroup.
+type UpdateEndpointGroupResult struct {
+	endpointGroupResult
+}
+
+// DeleteResult is the response from a DeleteEndpointGroup operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociationResult is the response from an operation adding or removing an
+// association with a project. Call its ExtractErr method to determine if the
+// request succeeded or failed.
+type AssociationResult struct {
+	gophercloud.ErrResult
+}
+
+// CheckAssociationResult is the response from an operation checking an
+// association with a project. Call its Extract method to determine whether
+// the association exists.
+type CheckAssociationResult struct {
+	isAssociated bool
+	gophercloud.Result
+}
+
+// Extract extracts CheckAssociationResult as bool and error values.
+func (r CheckAssociationResult) Extract() (bool, error) {
+	return r.isAssociated, r.Err
+}
+
+// EndpointGroupPage is a single page of EndpointGroup results.
+type EndpointGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an EndpointGroupPage contains any
+// results.
+func (r EndpointGroupPage) IsEmpty() (bool, error) {
+	endpointGroups, err := ExtractEndpointGroups(r)
+	return len(endpointGroups) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r EndpointGroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractEndpointGroups returns a slice of EndpointGroups contained in a
+// single page of results.
+func ExtractEndpointGroups(r pagination.Page) ([]EndpointGroup, error) {
+	var s struct {
+		EndpointGroups []EndpointGroup `json:"endpoint_groups"`
+	}
+	err := (r.(EndpointGroupPage)).ExtractInto(&s)
+	return s.EndpointGroups, err
+}
@@ -0,0 +1,2 @@
+// endpointfilter unit tests
+package testing
@@ -0,0 +1,233 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/endpointfilter"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// EndpointGroupOutput provides a single endpoint group.
+const EndpointGroupOutput = `
+{
+    "endpoint_group": {
+        "description": "public compute",
+        "filters": {
+            "interface": "public",
+            "service_id": "1b501a"
+        },
+        "id": "ac4861",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+        },
+        "name": "public-compute"
+    }
+}
+`
+
+// ListEndpointGroupsOutput provides a single page of EndpointGroup results.
+const ListEndpointGroupsOutput = `
+{
+    "endpoint_groups": [
+        {
+            "description": "public compute",
+            "filters": {
+                "interface": "public",
+                "service_id": "1b501a"
+            },
+            "id": "ac4861",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861"
+            },
+            "name": "public-compute"
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// CreateEndpointGroupRequest provides the input to a CreateEndpointGroup
+// request.
+const CreateEndpointGroupRequest = `
+{
+    "endpoint_group": {
+        "description": "public compute",
+        "filters": {
+            "interface": "public",
+            "service_id": "1b501a"
+        },
+        "name": "public-compute"
+    }
+}
+`
+
+// UpdateEndpointGroupRequest provides the input to an UpdateEndpointGroup
+// request.
+const UpdateEndpointGroupRequest = `
+{
+    "endpoint_group": {
+        "description": ""
+    }
+}
+`
+
+// ListEndpointsOutput provides a single page of endpoints.
+const ListEndpointsOutput = `
+{
+    "endpoints": [
+        {
+            "id": "6fedc0",
+            "interface": "public",
+            "region": "RegionOne",
+            "service_id": "1b501a",
+            "url": "http://example.com/compute/v2.1"
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/projects/263fd9/endpoints",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// ListProjectsOutput provides a single page of projects.
+const ListProjectsOutput = `
+{
+    "projects": [
+        {
+            "domain_id": "default",
+            "enabled": true,
+            "id": "263fd9",
+            "is_domain": false,
+            "name": "demo"
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861/projects",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// ExpectedEndpointGroup is the endpoint group of EndpointGroupOutput.
+var ExpectedEndpointGroup = endpointfilter.EndpointGroup{
+	ID:          "ac4861",
+	Name:        "public-compute",
+	Description: "public compute",
+	Filters: endpointfilter.Filters{
+		Interface: gophercloud.AvailabilityPublic,
+		ServiceID: "1b501a",
+	},
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-EP-FILTER/endpoint_groups/ac4861",
+	},
+}
+
+// ExpectedEndpointsSlice is the slice of endpoints expected to be returned
+// from ListEndpointsOutput.
+var ExpectedEndpointsSlice = []endpoints.Endpoint{
+	{
+		ID:           "6fedc0",
+		Availability: gophercloud.AvailabilityPublic,
+		Region:       "RegionOne",
+		ServiceID:    "1b501a",
+		URL:          "http://example.com/compute/v2.1",
+	},
+}
+
+// HandleEndpointGroupsSuccessfully creates HTTP handlers for the endpoint
+// groups on the test handler mux.
+func HandleEndpointGroupsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"name": "public-compute"})
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ListEndpointGroupsOutput)
+		case "POST":
+			th.TestJSONRequest(t, r, CreateEndpointGroupRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, EndpointGroupOutput)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, EndpointGroupOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateEndpointGroupRequest)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, EndpointGroupOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/endpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListEndpointsOutput)
+	})
+}
+
+// HandleProjectAssociationsSuccessfully creates HTTP handlers for the
+// associations of endpoints and endpoint groups with projects on the test
+// handler mux.
+func HandleProjectAssociationsSuccessfully(t *testing.T) {
+	association := func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "PUT", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		case "HEAD":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	}
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/projects/263fd9", association)
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/263fd9/endpoints/6fedc0", association)
+
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/263fd9/endpoints/1f9c8a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	list := func(output string) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, output)
+		}
+	}
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoint_groups/ac4861/projects", list(ListProjectsOutput))
+	th.Mux.HandleFunc("/OS-EP-FILTER/endpoints/6fedc0/projects", list(ListProjectsOutput))
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/263fd9/endpoints", list(ListEndpointsOutput))
+	th.Mux.HandleFunc("/OS-EP-FILTER/projects/263fd9/endpoint_groups", list(ListEndpointGroupsOutput))
+}
@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/endpointfilter"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/projects"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestEndpointGroups(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEndpointGroupsSuccessfully(t)
+
+	allPages, err := endpointfilter.ListEndpointGroups(client.ServiceClient(), endpointfilter.ListEndpointGroupsOpts{
+		Name: "public-compute",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	endpointGroups, err := endpointfilter.ExtractEndpointGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []endpointfilter.EndpointGroup{ExpectedEndpointGroup}, endpointGroups)
+
+	createOpts := endpointfilter.CreateEndpointGroupOpts{
+		Name:        "public-compute",
+		Description: "public compute",
+		Filters:     ExpectedEndpointGroup.Filters,
+	}
+	endpointGroup, err := endpointfilter.CreateEndpointGroup(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointGroup, *endpointGroup)
+
+	endpointGroup, err = endpointfilter.GetEndpointGroup(client.ServiceClient(), "ac4861").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointGroup, *endpointGroup)
+
+	description := ""
+	_, err = endpointfilter.UpdateEndpointGroup(client.ServiceClient(), "ac4861", endpointfilter.UpdateEndpointGroupOpts{
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	allPages, err = endpointfilter.ListEndpointGroupEndpoints(client.ServiceClient(), "ac4861").AllPages()
+	th.AssertNoErr(t, err)
+	actualEndpoints, err := endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointsSlice, actualEndpoints)
+
+	err = endpointfilter.DeleteEndpointGroup(client.ServiceClient(), "ac4861").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateEndpointGroupMissingFilters(t *testing.T) {
+	err := endpointfilter.CreateEndpointGroup(client.ServiceClient(), endpointfilter.CreateEndpointGroupOpts{
+		Name: "public-compute",
+	}).Err
+	th.AssertErr(t, err)
+}
+
+func TestProjectAssociations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleProjectAssociationsSuccessfully(t)
+
+	th.AssertNoErr(t, endpointfilter.AddEndpointGroupToProject(client.ServiceClient(), "ac4861", "263fd9").ExtractErr())
+	associated, err := endpointfilter.CheckEndpointGroupInProject(client.ServiceClient(), "ac4861", "263fd9").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, associated)
+	th.AssertNoErr(t, endpointfilter.RemoveEndpointGroupFromProject(client.ServiceClient(), "ac4861", "263fd9").ExtractErr())
+
+	th.AssertNoErr(t, endpointfilter.AddEndpointToProject(client.ServiceClient(), "263fd9", "6fedc0").ExtractErr())
+	associated, err = endpointfilter.CheckEndpointInProject(client.ServiceClient(), "263fd9", "6fedc0").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, associated)
+	associated, err = endpointfilter.CheckEndpointInProject(client.ServiceClient(), "263fd9", "1f9c8a").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, associated)
+	th.AssertNoErr(t, endpointfilter.RemoveEndpointFromProject(client.ServiceClient(), "263fd9", "6fedc0").ExtractErr())
+
+	allPages, err := endpointfilter.ListProjectEndpoints(client.ServiceClient(), "263fd9").AllPages()
+	th.AssertNoErr(t, err)
+	actualEndpoints, err := endpoints.ExtractEndpoints(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEndpointsSlice, actualEndpoints)
+
+	allPages, err = endpointfilter.ListProjectEndpointGroups(client.ServiceClient(), "263fd9").AllPages()
+	th.AssertNoErr(t, err)
+	endpointGroups, err := endpointfilter.ExtractEndpointGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []endpointfilter.EndpointGroup{ExpectedEndpointGroup}, endpointGroups)
+
+	allPages, err = endpointfilter.ListEndpointGroupProjects(client.ServiceClient(), "ac4861").AllPages()
+	th.AssertNoErr(t, err)
+	actualProjects, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actualProjects))
+	th.AssertEquals(t, "263fd9", actualProjects[0].ID)
+
+	allPages, err = endpointfilter.ListEndpointProjects(client.ServiceClient(), "6fedc0").AllPages()
+	th.AssertNoErr(t, err)
+	actualProjects, err = projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actualProjects))
+	th.AssertEquals(t, "263fd9", actualProjects[0].ID)
+}
@@ -0,0 +1,41 @@
+package endpointfilter
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+const rootPath = "OS-EP-FILTER"
+
+func endpointGroupsURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath, "endpoint_groups")
+}
+
+func endpointGroupURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, "endpoint_groups", endpointGroupID)
+}
+
+func endpointGroupProjectsURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, "endpoint_groups", endpointGroupID, "projects")
+}
+
+func endpointGroupProjectURL(client *gophercloud.ServiceClient, endpointGroupID, projectID string) string {
+	return client.ServiceURL(rootPath, "endpoint_groups", endpointGroupID, "projects", projectID)
+}
+
+func endpointGroupEndpointsURL(client *gophercloud.ServiceClient, endpointGroupID string) string {
+	return client.ServiceURL(rootPath, "endpoint_groups", endpointGroupID, "endpoints")
+}
+
+func projectEndpointsURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL(rootPath, "projects", projectID, "endpoints")
+}
+
+func projectEndpointURL(client *gophercloud.ServiceClient, projectID, endpointID string) string {
+	return client.ServiceURL(rootPath, "projects", projectID, "endpoints", endpointID)
+}
+
+func projectEndpointGroupsURL(client *gophercloud.ServiceClient, projectID string) string {
+	return client.ServiceURL(rootPath, "projects", projectID, "endpoint_groups")
+}
+
+func endpointProjectsURL(client *gophercloud.ServiceClient, endpointID string) string {
+	return client.ServiceURL(rootPath, "endpoints", endpointID, "projects")
+}
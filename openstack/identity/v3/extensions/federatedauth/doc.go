@@ -0,0 +1,77 @@
+/*
+Package federatedauth enables authentication through the identity providers
+federated with Keystone by the OS-FEDERATION extension. An unscoped token is
+obtained from /OS-FEDERATION/identity_providers/{idp}/protocols/{protocol}/auth
+with the assertion of the identity provider, and is then exchanged for a token
+with the requested scope.
+
+The supported methods are the OpenID Connect resource owner password,
+client credentials and access token flows, SAML2 ECP, and Kerberos. They
+correspond to the v3oidcpassword, v3oidcclientcredentials, v3oidcaccesstoken,
+v3samlpassword and v3kerberos plugins of keystoneauth.
+
+Example to Authenticate a client using OpenID Connect
+
+	client, err := openstack.NewClient("https://keystone.example.com/v3")
+	if err != nil {
+		panic(err)
+	}
+
+	authOptions := &federatedauth.AuthOptions{
+		IdentityProvider: "keycloak",
+		Protocol:         "openid",
+		Method: federatedauth.OIDCPassword{
+			ClientID:          "keystone",
+			ClientSecret:      "secret",
+			DiscoveryEndpoint: "https://keycloak.example.com/realms/corp/.well-known/openid-configuration",
+			Username:          "alice",
+			Password:          "wonderland",
+		},
+		Scope: tokens.Scope{
+			ProjectName: "demo",
+			DomainName:  "Federated",
+		},
+		AllowReauth: true,
+	}
+
+	err = openstack.AuthenticateV3(client, authOptions, gophercloud.EndpointOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Token using SAML2 ECP
+
+	authOptions := &federatedauth.AuthOptions{
+		IdentityProvider: "shibboleth",
+		Protocol:         "saml2",
+		Method: federatedauth.SAML2Password{
+			IdentityProviderURL: "https://idp.example.com/idp/profile/SAML2/SOAP/ECP",
+			Username:            "alice",
+			Password:            "wonderland",
+		},
+	}
+
+	token, err := federatedauth.Create(identityClient, authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Token using Kerberos
+
+	authOptions := &federatedauth.AuthOptions{
+		IdentityProvider: "kerberos",
+		Protocol:         "kerberos",
+		Method: federatedauth.Kerberos{
+			Negotiate: func(url string) (string, error) {
+				// Return a SPNEGO token for the HTTP service principal of
+				// the host of url, obtained with a Kerberos library.
+			},
+		},
+	}
+
+	token, err := federatedauth.Create(identityClient, authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+*/
+package federatedauth
@@ -0,0 +1,54 @@
+package federatedauth
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ErrCreateNotSupported is returned when federated AuthOptions are passed to
+// tokens.Create instead of Create.
+type ErrCreateNotSupported struct{ gophercloud.BaseError }
+
+func (e ErrCreateNotSupported) Error() string {
+	return "Federated authentication options must be used with federatedauth.Create or openstack.AuthenticateV3"
+}
+
+// ErrNoOIDCToken is returned when the token endpoint of an OpenID Connect
+// provider does not return the requested kind of token.
+type ErrNoOIDCToken struct {
+	gophercloud.BaseError
+	TokenType string
+}
+
+func (e ErrNoOIDCToken) Error() string {
+	return fmt.Sprintf("The OpenID Connect provider did not return an %s", e.TokenType)
+}
+
+// ErrInvalidSAML2Response is returned when a SOAP envelope exchanged during
+// SAML2 ECP authentication lacks a required element or attribute.
+type ErrInvalidSAML2Response struct {
+	gophercloud.BaseError
+	Party   string
+	Element string
+}
+
+func (e ErrInvalidSAML2Response) Error() string {
+	return fmt.Sprintf("Invalid SAML2 ECP response from the %s: missing %s", e.Party, e.Element)
+}
+
+// ErrConsumerURLMismatch is returned when the identity provider asks for the
+// SAML2 assertion to be sent to another URL than the one the service
+// provider expects it on. The assertion is not sent in that case.
+type ErrConsumerURLMismatch struct {
+	gophercloud.BaseError
+	ServiceProviderURL  string
+	IdentityProviderURL string
+}
+
+func (e ErrConsumerURLMismatch) Error() string {
+	return fmt.Sprintf(
+		"The identity provider asked for the SAML2 assertion to be sent to %s, but the service provider expects it on %s",
+		e.IdentityProviderURL, e.ServiceProviderURL,
+	)
+}
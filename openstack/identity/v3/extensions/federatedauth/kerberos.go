@@ -0,0 +1,36 @@
+package federatedauth
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+)
+
+// Kerberos authenticates with SPNEGO, for Keystone deployments that map
+// Kerberos principals to users with a federation protocol, as the
+// v3kerberos and mapped Kerberos plugins of keystoneauth do.
+//
+// Gophercloud does not implement Kerberos itself: Negotiate must be provided
+// by a Kerberos library, such as github.com/jcmturner/gokrb5.
+type Kerberos struct {
+	// Negotiate returns the base64-encoded SPNEGO token that authenticates
+	// the request to url, for the HTTP service principal of its host.
+	Negotiate func(url string) (string, error)
+}
+
+// Authenticate implements Method.
+func (opts Kerberos) Authenticate(client *gophercloud.ServiceClient, url string) (r tokens.CreateResult) {
+	if opts.Negotiate == nil {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Negotiate"}
+		return
+	}
+
+	token, err := opts.Negotiate(url)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return federatedToken(client, "GET", url, map[string]string{
+		"Authorization": "Negotiate " + token,
+	})
+}
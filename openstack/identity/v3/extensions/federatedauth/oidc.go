@@ -0,0 +1,247 @@
+package federatedauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+)
+
+// OIDCTokenType selects the token of an OpenID Connect token response that
+// is presented to Keystone.
+type OIDCTokenType string
+
+const (
+	// OIDCAccessTokenType presents the OAuth 2.0 access token. This is the
+	// default.
+	OIDCAccessTokenType OIDCTokenType = "access_token"
+
+	// OIDCIDTokenType presents the OpenID Connect ID token.
+	OIDCIDTokenType OIDCTokenType = "id_token"
+)
+
+// OIDCPassword authenticates with the OpenID Connect resource owner password
+// credentials grant, as the v3oidcpassword plugin of keystoneauth does.
+type OIDCPassword struct {
+	// ClientID and ClientSecret identify the OpenID Connect client registered
+	// for Keystone at the OpenID Connect provider.
+	ClientID     string
+	ClientSecret string
+
+	// DiscoveryEndpoint is the URL of the OpenID Connect discovery document
+	// of the provider, usually ending with /.well-known/openid-configuration.
+	// It is only used to find the token endpoint, and is not needed if
+	// AccessTokenEndpoint is set.
+	DiscoveryEndpoint string
+
+	// AccessTokenEndpoint is the URL of the token endpoint of the provider.
+	AccessTokenEndpoint string
+
+	// Username and Password are the credentials of the user at the provider.
+	Username string
+	Password string
+
+	// Scopes are the OpenID Connect scopes requested. They default to
+	// "openid".
+	Scopes []string
+
+	// TokenType selects the token presented to Keystone. It defaults to
+	// OIDCAccessTokenType.
+	TokenType OIDCTokenType
+}
+
+// Authenticate implements Method.
+func (opts OIDCPassword) Authenticate(client *gophercloud.ServiceClient, url string) (r tokens.CreateResult) {
+	if opts.Username == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Username"}
+		return
+	}
+	if opts.Password == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "Password"}
+		return
+	}
+
+	token, err := oidcToken(client, oidcClient{
+		ClientID:            opts.ClientID,
+		ClientSecret:        opts.ClientSecret,
+		DiscoveryEndpoint:   opts.DiscoveryEndpoint,
+		AccessTokenEndpoint: opts.AccessTokenEndpoint,
+		Scopes:              opts.Scopes,
+		TokenType:           opts.TokenType,
+	}, map[string]string{
+		"grant_type": "password",
+		"username":   opts.Username,
+		"password":   opts.Password,
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return bearerToken(client, url, token)
+}
+
+// OIDCClientCredentials authenticates with the OpenID Connect client
+// credentials grant, as the v3oidcclientcredentials plugin of keystoneauth
+// does. The identity is the one of the OpenID Connect client itself.
+type OIDCClientCredentials struct {
+	// ClientID and ClientSecret identify the OpenID Connect client at the
+	// OpenID Connect provider.
+	ClientID     string
+	ClientSecret string
+
+	// DiscoveryEndpoint is the URL of the OpenID Connect discovery document
+	// of the provider. It is not needed if AccessTokenEndpoint is set.
+	DiscoveryEndpoint string
+
+	// AccessTokenEndpoint is the URL of the token endpoint of the provider.
+	AccessTokenEndpoint string
+
+	// Scopes are the OpenID Connect scopes requested. They default to
+	// "openid".
+	Scopes []string
+
+	// TokenType selects the token presented to Keystone. It defaults to
+	// OIDCAccessTokenType.
+	TokenType OIDCTokenType
+}
+
+// Authenticate implements Method.
+func (opts OIDCClientCredentials) Authenticate(client *gophercloud.ServiceClient, url string) (r tokens.CreateResult) {
+	if opts.ClientSecret == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "ClientSecret"}
+		return
+	}
+
+	token, err := oidcToken(client, oidcClient{
+		ClientID:            opts.ClientID,
+		ClientSecret:        opts.ClientSecret,
+		DiscoveryEndpoint:   opts.DiscoveryEndpoint,
+		AccessTokenEndpoint: opts.AccessTokenEndpoint,
+		Scopes:              opts.Scopes,
+		TokenType:           opts.TokenType,
+	}, map[string]string{
+		"grant_type": "client_credentials",
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return bearerToken(client, url, token)
+}
+
+// OIDCAccessToken authenticates with an access token obtained beforehand
+// from the OpenID Connect provider, as the v3oidcaccesstoken plugin of
+// keystoneauth does. As access tokens are short-lived, reauthentication only
+// succeeds as long as AccessToken is valid.
+type OIDCAccessToken struct {
+	AccessToken string
+}
+
+// Authenticate implements Method.
+func (opts OIDCAccessToken) Authenticate(client *gophercloud.ServiceClient, url string) (r tokens.CreateResult) {
+	if opts.AccessToken == "" {
+		r.Err = gophercloud.ErrMissingInput{Argument: "AccessToken"}
+		return
+	}
+	return bearerToken(client, url, opts.AccessToken)
+}
+
+// bearerToken exchanges an OpenID Connect token for an unscoped Keystone
+// token.
+func bearerToken(client *gophercloud.ServiceClient, url, token string) tokens.CreateResult {
+	return federatedToken(client, "POST", url, map[string]string{
+		"Authorization": "Bearer " + token,
+	})
+}
+
+type oidcClient struct {
+	ClientID            string
+	ClientSecret        string
+	DiscoveryEndpoint   string
+	AccessTokenEndpoint string
+	Scopes              []string
+	TokenType           OIDCTokenType
+}
+
+// oidcToken requests a token from the token endpoint of the OpenID Connect
+// provider of c, with the grant described by params.
+func oidcToken(client *gophercloud.ServiceClient, c oidcClient, params map[string]string) (string, error) {
+	if c.ClientID == "" {
+		return "", gophercloud.ErrMissingInput{Argument: "ClientID"}
+	}
+
+	hc := client.HTTPClient
+	ctx := requestContext(client)
+
+	endpoint := c.AccessTokenEndpoint
+	if endpoint == "" {
+		if c.DiscoveryEndpoint == "" {
+			return "", gophercloud.ErrMissingInput{Argument: "DiscoveryEndpoint"}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", c.DiscoveryEndpoint, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		_, body, err := do(&hc, req, 200)
+		if err != nil {
+			return "", err
+		}
+
+		var discovery struct {
+			TokenEndpoint string `json:"token_endpoint"`
+		}
+		if err := json.Unmarshal(body, &discovery); err != nil {
+			return "", err
+		}
+		if discovery.TokenEndpoint == "" {
+			return "", gophercloud.ErrMissingInput{Argument: "token_endpoint"}
+		}
+		endpoint = discovery.TokenEndpoint
+	}
+
+	form := url.Values{}
+	for k, v := range params {
+		form.Set(k, v)
+	}
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid"}
+	}
+	form.Set("scope", strings.Join(scopes, " "))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+
+	_, body, err := do(&hc, req, 200)
+	if err != nil {
+		return "", err
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", err
+	}
+
+	tokenType := c.TokenType
+	if tokenType == "" {
+		tokenType = OIDCAccessTokenType
+	}
+	token, _ := resp[string(tokenType)].(string)
+	if token == "" {
+		return "", ErrNoOIDCToken{TokenType: string(tokenType)}
+	}
+	return token, nil
+}
@@ -0,0 +1,176 @@
+package federatedauth
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+)
+
+// Method obtains an unscoped token from the federated authentication
+// endpoint of an identity provider and protocol, by presenting the assertion
+// of the identity provider that the Keystone protocol expects.
+//
+// OIDCPassword, OIDCClientCredentials, OIDCAccessToken, SAML2Password and
+// Kerberos implement Method. Other mapped protocols can be supported by
+// implementing it.
+type Method interface {
+	// Authenticate obtains an unscoped token from url, the
+	// /OS-FEDERATION/identity_providers/{idp}/protocols/{protocol}/auth URL
+	// of the identity service client points to.
+	Authenticate(client *gophercloud.ServiceClient, url string) tokens.CreateResult
+}
+
+// AuthOptions represents options for authenticating a user through a
+// federated identity provider.
+type AuthOptions struct {
+	// IdentityProvider is the ID of the identity provider, as registered in
+	// Keystone.
+	IdentityProvider string
+
+	// Protocol is the ID of the federation protocol, as registered in
+	// Keystone for IdentityProvider, such as "openid", "saml2", "mapped" or
+	// "kerberos".
+	Protocol string
+
+	// Method is the authentication method used with the identity provider.
+	Method Method
+
+	// Scope is the scope the unscoped federated token is exchanged for. If
+	// empty, the unscoped token is used as is.
+	Scope tokens.Scope
+
+	// AllowReauth allows Gophercloud to re-authenticate automatically
+	// if/when your token expires.
+	AllowReauth bool
+}
+
+// ToTokenV3CreateMap allows AuthOptions to satisfy the AuthOptionsBuilder
+// interface in the v3 tokens package. Federated tokens cannot be requested
+// with tokens.Create: use Create instead.
+func (opts *AuthOptions) ToTokenV3CreateMap(map[string]interface{}) (map[string]interface{}, error) {
+	return nil, ErrCreateNotSupported{}
+}
+
+// ToTokenV3ScopeMap builds a scope request body from AuthOptions.
+func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	scope := gophercloud.AuthScope(opts.Scope)
+
+	gophercloudAuthOpts := gophercloud.AuthOptions{
+		Scope: &scope,
+	}
+
+	return gophercloudAuthOpts.ToTokenV3ScopeMap()
+}
+
+// ToTokenV3HeadersMap allows AuthOptions to satisfy the AuthOptionsBuilder
+// interface in the v3 tokens package.
+func (opts *AuthOptions) ToTokenV3HeadersMap(map[string]interface{}) (map[string]string, error) {
+	return nil, nil
+}
+
+// CanReauth allows AuthOptions to satisfy the AuthOptionsBuilder interface in
+// the v3 tokens package.
+func (opts *AuthOptions) CanReauth() bool {
+	return opts.AllowReauth
+}
+
+// Create obtains an unscoped token from the identity provider with
+// opts.Method and, if opts.Scope is set, exchanges it for a token with that
+// scope.
+func Create(client *gophercloud.ServiceClient, opts *AuthOptions) (r tokens.CreateResult) {
+	switch {
+	case opts.IdentityProvider == "":
+		r.Err = gophercloud.ErrMissingInput{Argument: "IdentityProvider"}
+		return
+	case opts.Protocol == "":
+		r.Err = gophercloud.ErrMissingInput{Argument: "Protocol"}
+		return
+	case opts.Method == nil:
+		r.Err = gophercloud.ErrMissingInput{Argument: "Method"}
+		return
+	}
+
+	r = opts.Method.Authenticate(client, authURL(client, opts.IdentityProvider, opts.Protocol))
+	if r.Err != nil || opts.Scope == (tokens.Scope{}) {
+		return
+	}
+
+	tokenID, err := r.ExtractTokenID()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	return tokens.Create(client, &tokens.AuthOptions{
+		TokenID: tokenID,
+		Scope:   opts.Scope,
+	})
+}
+
+// federatedToken requests an unscoped token from url, the federated
+// authentication endpoint, authenticating with headers.
+func federatedToken(client *gophercloud.ServiceClient, method, url string, headers map[string]string) (r tokens.CreateResult) {
+	resp, err := client.Request(method, url, &gophercloud.RequestOpts{
+		JSONResponse: &r.Body,
+		MoreHeaders:  headers,
+		OkCodes:      []int{200, 201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// requestContext returns the context requests made on behalf of client are
+// bound to.
+func requestContext(client *gophercloud.ServiceClient) context.Context {
+	if ctx := client.RequestContext(); ctx != nil {
+		return ctx
+	}
+	if client.ProviderClient.Context != nil {
+		return client.ProviderClient.Context
+	}
+	return context.Background()
+}
+
+// do sends req, a request to a third party such as an identity provider,
+// with hc, and returns the body of the response. The request does not go
+// through the ProviderClient, so that the Keystone token is never sent to
+// the third party. An ErrUnexpectedResponseCode is returned if the response
+// code is not one of okCodes.
+func do(hc *http.Client, req *http.Request, okCodes ...int) (*http.Response, []byte, error) {
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, code := range okCodes {
+		if resp.StatusCode == code {
+			return resp, body, nil
+		}
+	}
+	return nil, nil, gophercloud.ErrUnexpectedResponseCode{
+		URL:            req.URL.String(),
+		Method:         req.Method,
+		Expected:       okCodes,
+		Actual:         resp.StatusCode,
+		Body:           body,
+		ResponseHeader: resp.Header,
+	}
+}
+
+// tokenResult builds a tokens.CreateResult out of a token response that was
+// not received through the ProviderClient.
+func tokenResult(resp *http.Response, body []byte) (r tokens.CreateResult) {
+	r.Header = resp.Header
+	r.Err = json.Unmarshal(body, &r.Body)
+	return
+}
@@ -0,0 +1,335 @@
+package federatedauth
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"sort"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+)
+
+const (
+	soapNS = "http://schemas.xmlsoap.org/soap/envelope/"
+	paosNS = "urn:liberty:paos:2003-08"
+	ecpNS  = "urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"
+
+	paosContentType = "application/vnd.paos+xml"
+	paosHeader      = `ver="` + paosNS + `";"` + ecpNS + `"`
+)
+
+// SAML2Password authenticates with the SAML2 Enhanced Client or Proxy (ECP)
+// profile, as the v3samlpassword plugin of keystoneauth does: the
+// authentication request of Keystone is relayed to the ECP endpoint of the
+// identity provider, which is authenticated against with HTTP basic
+// authentication, and the resulting assertion is relayed back to Keystone.
+type SAML2Password struct {
+	// IdentityProviderURL is the URL of the SAML2 ECP endpoint of the
+	// identity provider, such as
+	// https://idp.example.com/idp/profile/SAML2/SOAP/ECP.
+	IdentityProviderURL string
+
+	// Username and Password are the credentials of the user at the identity
+	// provider.
+	Username string
+	Password string
+}
+
+// Authenticate implements Method.
+func (opts SAML2Password) Authenticate(client *gophercloud.ServiceClient, url string) (r tokens.CreateResult) {
+	switch {
+	case opts.IdentityProviderURL == "":
+		r.Err = gophercloud.ErrMissingInput{Argument: "IdentityProviderURL"}
+		return
+	case opts.Username == "":
+		r.Err = gophercloud.ErrMissingInput{Argument: "Username"}
+		return
+	case opts.Password == "":
+		r.Err = gophercloud.ErrMissingInput{Argument: "Password"}
+		return
+	}
+
+	// The service provider keeps track of the exchange with a session
+	// cookie. The requests are not sent through the ProviderClient, which
+	// would send the Keystone token to the identity provider.
+	hc := client.HTTPClient
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	hc.Jar = jar
+	ctx := requestContext(client)
+
+	// Ask the service provider for an authentication request.
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req.Header.Set("Accept", paosContentType)
+	req.Header.Set("PAOS", paosHeader)
+	resp, spEnvelope, err := do(&hc, req, 200, 201)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if resp.Header.Get("X-Subject-Token") != "" {
+		// The session is already authenticated.
+		return tokenResult(resp, spEnvelope)
+	}
+
+	authnRequest, relayState, spConsumerURL, err := parseSPEnvelope(spEnvelope)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	// Authenticate against the identity provider.
+	req, err = http.NewRequestWithContext(ctx, "POST", opts.IdentityProviderURL, bytes.NewReader(authnRequest))
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req.Header.Set("Content-Type", "text/xml")
+	req.SetBasicAuth(opts.Username, opts.Password)
+	_, idpEnvelope, err := do(&hc, req, 200)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	authnResponse, idpConsumerURL, err := buildAuthnResponse(idpEnvelope, relayState)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	// Never hand the assertion to another party than the service provider
+	// that asked for it.
+	if idpConsumerURL != spConsumerURL {
+		r.Err = ErrConsumerURLMismatch{
+			ServiceProviderURL:  spConsumerURL,
+			IdentityProviderURL: idpConsumerURL,
+		}
+		return
+	}
+
+	// Relay the assertion to the service provider, which redirects to url
+	// once the session is authenticated.
+	req, err = http.NewRequestWithContext(ctx, "POST", idpConsumerURL, bytes.NewReader(authnResponse))
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req.Header.Set("Content-Type", paosContentType)
+	resp, body, err := do(&hc, req, 200, 201)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	if resp.Header.Get("X-Subject-Token") == "" {
+		// The service provider did not redirect to url, request the token
+		// explicitly with the authenticated session.
+		req, err = http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		req.Header.Set("Accept", "application/json")
+		resp, body, err = do(&hc, req, 200, 201)
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+
+	return tokenResult(resp, body)
+}
+
+// parseSPEnvelope extracts from the SOAP envelope sent by the service
+// provider the authentication request to forward to the identity provider,
+// which is the envelope without its header, the RelayState element to send
+// back to the service provider, and the URL the service provider expects the
+// assertion on.
+func parseSPEnvelope(envelope []byte) (authnRequest, relayState []byte, consumerURL string, err error) {
+	elements, err := parseXMLElements(envelope)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	header := findXMLElement(elements, soapNS, "Header")
+	if header == nil {
+		return nil, nil, "", ErrInvalidSAML2Response{Party: "service provider", Element: "S:Header"}
+	}
+
+	request := findXMLElement(elements, paosNS, "Request")
+	if request != nil {
+		consumerURL = request.attr("responseConsumerURL")
+	}
+	if consumerURL == "" {
+		return nil, nil, "", ErrInvalidSAML2Response{Party: "service provider", Element: "paos:Request/@responseConsumerURL"}
+	}
+
+	relay := findXMLElement(elements, ecpNS, "RelayState")
+	if relay == nil {
+		return nil, nil, "", ErrInvalidSAML2Response{Party: "service provider", Element: "ecp:RelayState"}
+	}
+
+	authnRequest = append(append([]byte{}, envelope[:header.start]...), envelope[header.end:]...)
+	return authnRequest, relay.standalone(envelope), consumerURL, nil
+}
+
+// buildAuthnResponse replaces the ecp:Response header of the SOAP envelope
+// sent by the identity provider with relayState, and returns the resulting
+// envelope, to be sent to the service provider, as well as the URL the
+// identity provider asks for the assertion to be sent to.
+func buildAuthnResponse(envelope, relayState []byte) ([]byte, string, error) {
+	elements, err := parseXMLElements(envelope)
+	if err != nil {
+		return nil, "", err
+	}
+
+	response := findXMLElement(elements, ecpNS, "Response")
+	if response == nil {
+		return nil, "", ErrInvalidSAML2Response{Party: "identity provider", Element: "ecp:Response"}
+	}
+	consumerURL := response.attr("AssertionConsumerServiceURL")
+	if consumerURL == "" {
+		return nil, "", ErrInvalidSAML2Response{Party: "identity provider", Element: "ecp:Response/@AssertionConsumerServiceURL"}
+	}
+
+	var b bytes.Buffer
+	b.Write(envelope[:response.start])
+	b.Write(relayState)
+	b.Write(envelope[response.end:])
+	return b.Bytes(), consumerURL, nil
+}
+
+// xmlElement is an element of an XML document, located by the byte offsets
+// of its start and end in the document.
+type xmlElement struct {
+	name       xml.Name
+	attrs      []xml.Attr
+	start, end int
+
+	// ns holds the namespace declarations in scope for the element, by
+	// prefix.
+	ns map[string]string
+}
+
+func (e *xmlElement) attr(local string) string {
+	for _, a := range e.attrs {
+		if a.Name.Local == local && a.Name.Space != "xmlns" {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// standalone returns the element, as found in doc, with the namespace
+// declarations it inherits from its ancestors, so that it can be moved to
+// another document.
+func (e *xmlElement) standalone(doc []byte) []byte {
+	raw := doc[e.start:e.end]
+
+	declared := make(map[string]bool)
+	for _, a := range e.attrs {
+		switch {
+		case a.Name.Space == "xmlns":
+			declared[a.Name.Local] = true
+		case a.Name.Space == "" && a.Name.Local == "xmlns":
+			declared[""] = true
+		}
+	}
+
+	prefixes := make([]string, 0, len(e.ns))
+	for prefix := range e.ns {
+		if !declared[prefix] {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	sort.Strings(prefixes)
+
+	var decls bytes.Buffer
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			decls.WriteString(` xmlns="`)
+		} else {
+			decls.WriteString(` xmlns:` + prefix + `="`)
+		}
+		_ = xml.EscapeText(&decls, []byte(e.ns[prefix]))
+		decls.WriteString(`"`)
+	}
+
+	// Insert the declarations right after the name of the element.
+	i := 1 + strings.IndexAny(string(raw[1:]), " \t\r\n/>")
+	var b bytes.Buffer
+	b.Write(raw[:i])
+	b.Write(decls.Bytes())
+	b.Write(raw[i:])
+	return b.Bytes()
+}
+
+// parseXMLElements returns the elements of doc, in document order.
+func parseXMLElements(doc []byte) ([]xmlElement, error) {
+	var elements []xmlElement
+	var stack []int
+
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	for {
+		start := int(d.InputOffset())
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return elements, nil
+			}
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			ns := make(map[string]string)
+			if len(stack) > 0 {
+				for k, v := range elements[stack[len(stack)-1]].ns {
+					ns[k] = v
+				}
+			}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "xmlns":
+					ns[a.Name.Local] = a.Value
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					ns[""] = a.Value
+				}
+			}
+
+			elements = append(elements, xmlElement{
+				name:  t.Name,
+				attrs: t.Attr,
+				start: start,
+				ns:    ns,
+			})
+			stack = append(stack, len(elements)-1)
+		case xml.EndElement:
+			elements[stack[len(stack)-1]].end = int(d.InputOffset())
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// findXMLElement returns the first element named local in the namespace
+// space, or nil.
+func findXMLElement(elements []xmlElement, space, local string) *xmlElement {
+	for i := range elements {
+		if elements[i].name.Space == space && elements[i].name.Local == local {
+			return &elements[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,2 @@
+// federatedauth unit tests
+package testing
@@ -0,0 +1,184 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	tokens "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens/testing"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+// UnscopedTokenID is the ID of the unscoped federated token.
+const UnscopedTokenID = "3e2e7e1e1e5b4e0f9d4f7d6c1a2b3c4d"
+
+// ScopedTokenID is the ID of the token the unscoped token is exchanged for.
+const ScopedTokenID = "9f8e7d6c5b4a39281706f5e4d3c2b1a0"
+
+// UnscopedTokenOutput is a sample response to a federated authentication
+// request.
+const UnscopedTokenOutput = `
+{
+    "token": {
+        "methods": ["openid"],
+        "user": {
+            "domain": {"id": "Federated", "name": "Federated"},
+            "id": "5c2a5a9e8e4b4a1fb3d1a6e0b9c8d7e6",
+            "name": "alice",
+            "OS-FEDERATION": {
+                "identity_provider": {"id": "myidp"},
+                "protocol": {"id": "openid"},
+                "groups": [{"id": "e1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6"}]
+            }
+        },
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-02T02:19:49.000000Z"
+    }
+}
+`
+
+// RescopeRequest is the expected request exchanging the unscoped token for a
+// project-scoped one.
+const RescopeRequest = `
+{
+    "auth": {
+        "identity": {
+            "methods": ["token"],
+            "token": {"id": "3e2e7e1e1e5b4e0f9d4f7d6c1a2b3c4d"}
+        },
+        "scope": {
+            "project": {"id": "a99e9b4e620e4db09a2dfb6e42a01e66"}
+        }
+    }
+}
+`
+
+// SPEnvelope is a sample SAML2 ECP authentication request sent by the
+// service provider. The prefixes of the RelayState element are declared on
+// the envelope only.
+const SPEnvelope = `<S:Envelope xmlns:S="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"><S:Header>` +
+	`<paos:Request xmlns:paos="urn:liberty:paos:2003-08" S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1" ` +
+	`responseConsumerURL="%[1]sShibboleth.sso/SAML2/ECP" service="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"/>` +
+	`<ecp:RelayState S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1">ss:mem:6f1f20fafbb38433467e9d477df67615</ecp:RelayState>` +
+	`</S:Header><S:Body><samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_a1b2c3"/></S:Body></S:Envelope>`
+
+// IdPEnvelope is a sample SAML2 ECP response sent by the identity provider.
+const IdPEnvelope = `<soap11:Envelope xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/"><soap11:Header>` +
+	`<ecp:Response xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" AssertionConsumerServiceURL="%[1]sShibboleth.sso/SAML2/ECP" ` +
+	`soap11:actor="http://schemas.xmlsoap.org/soap/actor/next" soap11:mustUnderstand="1"/>` +
+	`</soap11:Header><soap11:Body><saml2p:Response xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol" ID="_d4e5f6"/></soap11:Body></soap11:Envelope>`
+
+// HandleFederatedAuthSuccessfully sets up the test server to respond to a
+// federated authentication request for the myidp identity provider and
+// protocol, authenticated with the authorization header.
+func HandleFederatedAuthSuccessfully(t *testing.T, method, protocol, authorization string) {
+	th.Mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/myidp/protocols/"+protocol+"/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "Authorization", authorization)
+
+		w.Header().Set("X-Subject-Token", UnscopedTokenID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, UnscopedTokenOutput)
+	})
+}
+
+// HandleRescopeSuccessfully sets up the test server to respond to the
+// exchange of the unscoped token for a project-scoped one.
+func HandleRescopeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, RescopeRequest)
+
+		w.Header().Set("X-Subject-Token", ScopedTokenID)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, tokens.TokenOutput)
+	})
+}
+
+// HandleOIDCTokenSuccessfully sets up the test server to act as an OpenID
+// Connect provider issuing tokens with the grant described by form.
+func HandleOIDCTokenSuccessfully(t *testing.T, form map[string]string) {
+	th.Mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"issuer": "%[1]s", "token_endpoint": "%[1]sprotocol/openid-connect/token"}`, th.Endpoint())
+	})
+
+	th.Mux.HandleFunc("/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+		th.AssertEquals(t, "", r.Header.Get("X-Auth-Token"))
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		th.AssertEquals(t, true, ok)
+		th.AssertEquals(t, "keystone", clientID)
+		th.AssertEquals(t, "s3cr3t", clientSecret)
+
+		th.AssertNoErr(t, r.ParseForm())
+		th.AssertEquals(t, "openid profile", r.PostForm.Get("scope"))
+		for k, v := range form {
+			th.AssertEquals(t, v, r.PostForm.Get(k))
+		}
+
+		fmt.Fprint(w, `{"access_token": "oidc-access-token", "id_token": "oidc-id-token", "token_type": "Bearer"}`)
+	})
+}
+
+// HandleSAML2ECPSuccessfully sets up the test server to act as both the
+// service provider and the identity provider of a SAML2 ECP exchange. The
+// identity provider asks for the assertion to be sent to consumerURL.
+func HandleSAML2ECPSuccessfully(t *testing.T, consumerURL string) {
+	th.Mux.HandleFunc("/v3/OS-FEDERATION/identity_providers/myidp/protocols/saml2/auth", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		if cookie, err := r.Cookie("_shibsession"); err == nil && cookie.Value == "authenticated" {
+			w.Header().Set("X-Subject-Token", UnscopedTokenID)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, UnscopedTokenOutput)
+			return
+		}
+
+		th.TestHeader(t, r, "Accept", "application/vnd.paos+xml")
+		th.TestHeader(t, r, "PAOS", `ver="urn:liberty:paos:2003-08";"urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp"`)
+		w.Header().Set("Content-Type", "application/vnd.paos+xml")
+		fmt.Fprintf(w, SPEnvelope, th.Endpoint())
+	})
+
+	th.Mux.HandleFunc("/idp/profile/SAML2/SOAP/ECP", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "text/xml")
+
+		username, password, ok := r.BasicAuth()
+		th.AssertEquals(t, true, ok)
+		th.AssertEquals(t, "alice", username)
+		th.AssertEquals(t, "wonderland", password)
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t,
+			`<S:Envelope xmlns:S="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp">`+
+				`<S:Body><samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_a1b2c3"/></S:Body></S:Envelope>`,
+			string(b))
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, IdPEnvelope, consumerURL)
+	})
+
+	th.Mux.HandleFunc("/Shibboleth.sso/SAML2/ECP", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "Content-Type", "application/vnd.paos+xml")
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t,
+			`<soap11:Envelope xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/"><soap11:Header>`+
+				`<ecp:RelayState xmlns:S="http://schemas.xmlsoap.org/soap/envelope/" xmlns:ecp="urn:oasis:names:tc:SAML:2.0:profiles:SSO:ecp" `+
+				`S:actor="http://schemas.xmlsoap.org/soap/actor/next" S:mustUnderstand="1">ss:mem:6f1f20fafbb38433467e9d477df67615</ecp:RelayState>`+
+				`</soap11:Header><soap11:Body><saml2p:Response xmlns:saml2p="urn:oasis:names:tc:SAML:2.0:protocol" ID="_d4e5f6"/></soap11:Body></soap11:Envelope>`,
+			string(b))
+
+		http.SetCookie(w, &http.Cookie{Name: "_shibsession", Value: "authenticated", Path: "/"})
+		http.Redirect(w, r, strings.TrimSuffix(th.Endpoint(), "/")+"/v3/OS-FEDERATION/identity_providers/myidp/protocols/saml2/auth", http.StatusFound)
+	})
+}
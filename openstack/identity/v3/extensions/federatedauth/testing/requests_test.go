@@ -0,0 +1,190 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/federatedauth"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func newClient() *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       th.Endpoint() + "v3/",
+	}
+}
+
+func TestCreateOIDCPassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleOIDCTokenSuccessfully(t, map[string]string{
+		"grant_type": "password",
+		"username":   "alice",
+		"password":   "wonderland",
+	})
+	HandleFederatedAuthSuccessfully(t, "POST", "openid", "Bearer oidc-access-token")
+	HandleRescopeSuccessfully(t)
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		Method: federatedauth.OIDCPassword{
+			ClientID:          "keystone",
+			ClientSecret:      "s3cr3t",
+			DiscoveryEndpoint: th.Endpoint() + ".well-known/openid-configuration",
+			Username:          "alice",
+			Password:          "wonderland",
+			Scopes:            []string{"openid", "profile"},
+		},
+		Scope: tokens.Scope{ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66"},
+	}
+
+	r := federatedauth.Create(newClient(), &opts)
+	tokenID, err := r.ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ScopedTokenID, tokenID)
+
+	project, err := r.ExtractProject()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "a99e9b4e620e4db09a2dfb6e42a01e66", project.ID)
+}
+
+func TestCreateOIDCClientCredentialsIDToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleOIDCTokenSuccessfully(t, map[string]string{
+		"grant_type": "client_credentials",
+	})
+	HandleFederatedAuthSuccessfully(t, "POST", "openid", "Bearer oidc-id-token")
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		Method: federatedauth.OIDCClientCredentials{
+			ClientID:            "keystone",
+			ClientSecret:        "s3cr3t",
+			AccessTokenEndpoint: th.Endpoint() + "protocol/openid-connect/token",
+			Scopes:              []string{"openid", "profile"},
+			TokenType:           federatedauth.OIDCIDTokenType,
+		},
+	}
+
+	// Without a scope, the unscoped token is returned.
+	tokenID, err := federatedauth.Create(newClient(), &opts).ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, UnscopedTokenID, tokenID)
+}
+
+func TestCreateOIDCAccessToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFederatedAuthSuccessfully(t, "POST", "openid", "Bearer my-access-token")
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		Method:           federatedauth.OIDCAccessToken{AccessToken: "my-access-token"},
+	}
+
+	tokenID, err := federatedauth.Create(newClient(), &opts).ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, UnscopedTokenID, tokenID)
+}
+
+func TestCreateSAML2Password(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSAML2ECPSuccessfully(t, th.Endpoint())
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "saml2",
+		Method: federatedauth.SAML2Password{
+			IdentityProviderURL: th.Endpoint() + "idp/profile/SAML2/SOAP/ECP",
+			Username:            "alice",
+			Password:            "wonderland",
+		},
+	}
+
+	tokenID, err := federatedauth.Create(newClient(), &opts).ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, UnscopedTokenID, tokenID)
+}
+
+func TestCreateSAML2PasswordConsumerURLMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSAML2ECPSuccessfully(t, "https://evil.example.com/")
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "saml2",
+		Method: federatedauth.SAML2Password{
+			IdentityProviderURL: th.Endpoint() + "idp/profile/SAML2/SOAP/ECP",
+			Username:            "alice",
+			Password:            "wonderland",
+		},
+	}
+
+	err := federatedauth.Create(newClient(), &opts).Err
+	_, ok := err.(federatedauth.ErrConsumerURLMismatch)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestCreateKerberos(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFederatedAuthSuccessfully(t, "GET", "kerberos", "Negotiate c3BuZWdv")
+
+	opts := federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "kerberos",
+		Method: federatedauth.Kerberos{
+			Negotiate: func(url string) (string, error) {
+				th.AssertEquals(t, th.Endpoint()+"v3/OS-FEDERATION/identity_providers/myidp/protocols/kerberos/auth", url)
+				return "c3BuZWdv", nil
+			},
+		},
+	}
+
+	tokenID, err := federatedauth.Create(newClient(), &opts).ExtractTokenID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, UnscopedTokenID, tokenID)
+}
+
+func TestCreateMissingInput(t *testing.T) {
+	err := federatedauth.Create(newClient(), &federatedauth.AuthOptions{Protocol: "openid"}).Err
+	th.AssertEquals(t, "Missing input for argument [IdentityProvider]", err.Error())
+
+	err = federatedauth.Create(newClient(), &federatedauth.AuthOptions{IdentityProvider: "myidp", Protocol: "openid"}).Err
+	th.AssertEquals(t, "Missing input for argument [Method]", err.Error())
+}
+
+func TestAuthenticateV3(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleFederatedAuthSuccessfully(t, "POST", "openid", "Bearer my-access-token")
+	HandleRescopeSuccessfully(t)
+
+	provider, err := openstack.NewClient(th.Endpoint())
+	th.AssertNoErr(t, err)
+
+	opts := &federatedauth.AuthOptions{
+		IdentityProvider: "myidp",
+		Protocol:         "openid",
+		Method:           federatedauth.OIDCAccessToken{AccessToken: "my-access-token"},
+		Scope:            tokens.Scope{ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66"},
+		AllowReauth:      true,
+	}
+
+	err = openstack.AuthenticateV3(provider, opts, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ScopedTokenID, provider.Token())
+
+	provider.SetToken("")
+	th.AssertNoErr(t, provider.Reauthenticate(""))
+	th.AssertEquals(t, ScopedTokenID, provider.Token())
+}
@@ -0,0 +1,7 @@
+package federatedauth
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+func authURL(c *gophercloud.ServiceClient, idp, protocol string) string {
+	return c.ServiceURL("OS-FEDERATION", "identity_providers", idp, "protocols", protocol, "auth")
+}
@@ -0,0 +1,100 @@
+/*
+Package federation manages the OS-FEDERATION extension of the OpenStack
+Identity service: identity providers, their protocols, the mappings applied
+to their assertions and the service providers Keystone acts as identity
+provider for. To authenticate with a federated identity provider, see the
+federatedauth package.
+
+Example to Register an Identity Provider
+
+	enabled := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		Description: "Corporate identity provider",
+		Enabled:     &enabled,
+		RemoteIDs:   []string{"https://idp.example.com/idp/shibboleth"},
+	}
+
+	idp, err := federation.CreateIdentityProvider(identityClient, "myidp", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Mapping
+
+	createOpts := federation.MappingOpts{
+		Rules: []federation.Rule{
+			{
+				Local: []federation.LocalRule{
+					{
+						User: &federation.LocalUser{Name: "{0}"},
+					},
+					{
+						Groups: "{1}",
+						Domain: &federation.LocalDomain{ID: "default"},
+					},
+				},
+				Remote: []federation.RemoteRule{
+					{Type: "REMOTE_USER"},
+					{Type: "OIDC-groups", Whitelist: []string{"admins", "developers"}},
+				},
+			},
+		},
+	}
+
+	mapping, err := federation.CreateMapping(identityClient, "myidp_mapping", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add a Protocol to an Identity Provider
+
+	createOpts := federation.ProtocolOpts{
+		MappingID: "myidp_mapping",
+	}
+
+	protocol, err := federation.CreateProtocol(identityClient, "myidp", "openid", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Identity Providers
+
+	enabled := true
+	listOpts := federation.ListIdentityProvidersOpts{
+		Enabled: &enabled,
+	}
+
+	allPages, err := federation.ListIdentityProviders(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allIdentityProviders, err := federation.ExtractIdentityProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, idp := range allIdentityProviders {
+		fmt.Printf("%+v\n", idp)
+	}
+
+Example to List the Projects Available to a Federated Token
+
+	allPages, err := federation.ListProjects(federatedClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Provider
+
+	err := federation.DeleteServiceProvider(identityClient, "mysp").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package federation
@@ -0,0 +1,463 @@
+package federation
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/domains"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/projects"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ListIdentityProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListIdentityProviders request.
+type ListIdentityProvidersOptsBuilder interface {
+	ToIdentityProviderListQuery() (string, error)
+}
+
+// ListIdentityProvidersOpts provides options to filter the
+// ListIdentityProviders results.
+type ListIdentityProvidersOpts struct {
+	// ID filters the response by an identity provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled identity providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToIdentityProviderListQuery formats a ListIdentityProvidersOpts into a
+// query string.
+func (opts ListIdentityProvidersOpts) ToIdentityProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListIdentityProviders enumerates the identity providers.
+func ListIdentityProviders(client *gophercloud.ServiceClient, opts ListIdentityProvidersOptsBuilder) pagination.Pager {
+	url := identityProvidersURL(client)
+	if opts != nil {
+		query, err := opts.ToIdentityProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return IdentityProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateIdentityProvider request.
+type CreateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateIdentityProviderOpts provides options used to register an identity
+// provider.
+type CreateIdentityProviderOpts struct {
+	// DomainID is the ID of the domain federated users of the identity
+	// provider belong to. Keystone creates a domain if it is not set.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs are the identifiers the identity provider is known by in the
+	// assertions, such as the entity ID of a SAML2 identity provider.
+	RemoteIDs []string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes the group memberships of a
+	// federated user remain valid after their last authentication.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderCreateMap formats a CreateIdentityProviderOpts into a
+// create request.
+func (opts CreateIdentityProviderOpts) ToIdentityProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// CreateIdentityProvider registers an identity provider with the given ID.
+func CreateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts CreateIdentityProviderOptsBuilder) (r CreateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(identityProviderURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetIdentityProvider retrieves details on a single identity provider, by ID.
+func GetIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r GetIdentityProviderResult) {
+	resp, err := client.Get(identityProviderURL(client, idpID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateIdentityProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateIdentityProvider request.
+type UpdateIdentityProviderOptsBuilder interface {
+	ToIdentityProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateIdentityProviderOpts provides options used to update an identity
+// provider. The domain of an identity provider cannot be changed.
+type UpdateIdentityProviderOpts struct {
+	// Description is the description of the identity provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets the identity provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RemoteIDs are the identifiers the identity provider is known by in the
+	// assertions.
+	RemoteIDs *[]string `json:"remote_ids,omitempty"`
+
+	// AuthorizationTTL is the number of minutes the group memberships of a
+	// federated user remain valid after their last authentication.
+	AuthorizationTTL *int `json:"authorization_ttl,omitempty"`
+}
+
+// ToIdentityProviderUpdateMap formats an UpdateIdentityProviderOpts into an
+// update request.
+func (opts UpdateIdentityProviderOpts) ToIdentityProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "identity_provider")
+}
+
+// UpdateIdentityProvider modifies the attributes of an identity provider.
+func UpdateIdentityProvider(client *gophercloud.ServiceClient, idpID string, opts UpdateIdentityProviderOptsBuilder) (r UpdateIdentityProviderResult) {
+	b, err := opts.ToIdentityProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(identityProviderURL(client, idpID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteIdentityProvider deletes an identity provider, along with its
+// protocols.
+func DeleteIdentityProvider(client *gophercloud.ServiceClient, idpID string) (r DeleteResult) {
+	resp, err := client.Delete(identityProviderURL(client, idpID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListProtocols enumerates the protocols of an identity provider.
+func ListProtocols(client *gophercloud.ServiceClient, idpID string) pagination.Pager {
+	return pagination.NewPager(client, protocolsURL(client, idpID), func(r pagination.PageResult) pagination.Page {
+		return ProtocolPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ProtocolOptsBuilder allows extensions to add additional parameters to the
+// CreateProtocol and UpdateProtocol requests.
+type ProtocolOptsBuilder interface {
+	ToProtocolMap() (map[string]interface{}, error)
+}
+
+// ProtocolOpts provides options used to create or update a protocol.
+type ProtocolOpts struct {
+	// MappingID is the ID of the mapping applied to the assertions received
+	// with the protocol.
+	MappingID string `json:"mapping_id" required:"true"`
+
+	// RemoteIDAttribute is the attribute of the assertions holding the remote
+	// ID of the identity provider. It overrides the remote_id_attribute
+	// option of the Keystone configuration for the protocol.
+	RemoteIDAttribute string `json:"remote_id_attribute,omitempty"`
+}
+
+// ToProtocolMap formats a ProtocolOpts into a create or update request.
+func (opts ProtocolOpts) ToProtocolMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "protocol")
+}
+
+// CreateProtocol adds a protocol with the given ID, such as "saml2" or
+// "openid", to an identity provider.
+func CreateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r CreateProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(protocolURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetProtocol retrieves details on a single protocol of an identity provider.
+func GetProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r GetProtocolResult) {
+	resp, err := client.Get(protocolURL(client, idpID, protocolID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateProtocol modifies the attributes of a protocol of an identity
+// provider.
+func UpdateProtocol(client *gophercloud.ServiceClient, idpID, protocolID string, opts ProtocolOptsBuilder) (r UpdateProtocolResult) {
+	b, err := opts.ToProtocolMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(protocolURL(client, idpID, protocolID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteProtocol removes a protocol from an identity provider.
+func DeleteProtocol(client *gophercloud.ServiceClient, idpID, protocolID string) (r DeleteResult) {
+	resp, err := client.Delete(protocolURL(client, idpID, protocolID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListMappings enumerates the mappings.
+func ListMappings(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, mappingsURL(client), func(r pagination.PageResult) pagination.Page {
+		return MappingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// MappingOptsBuilder allows extensions to add additional parameters to the
+// CreateMapping and UpdateMapping requests.
+type MappingOptsBuilder interface {
+	ToMappingMap() (map[string]interface{}, error)
+}
+
+// MappingOpts provides options used to create or update a mapping.
+type MappingOpts struct {
+	// Rules are the rules mapping the attributes of the assertions to local
+	// users, groups and projects.
+	Rules []Rule `json:"rules" required:"true"`
+
+	// SchemaVersion is the version of the mapping schema the rules are
+	// written against, such as "2.0". Keystone defaults to "1.0".
+	SchemaVersion string `json:"schema_version,omitempty"`
+}
+
+// ToMappingMap formats a MappingOpts into a create or update request.
+func (opts MappingOpts) ToMappingMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "mapping")
+}
+
+// CreateMapping creates a mapping with the given ID.
+func CreateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r CreateMappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(mappingURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetMapping retrieves details on a single mapping, by ID.
+func GetMapping(client *gophercloud.ServiceClient, mappingID string) (r GetMappingResult) {
+	resp, err := client.Get(mappingURL(client, mappingID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateMapping replaces the rules of a mapping.
+func UpdateMapping(client *gophercloud.ServiceClient, mappingID string, opts MappingOptsBuilder) (r UpdateMappingResult) {
+	b, err := opts.ToMappingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(mappingURL(client, mappingID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMapping deletes a mapping.
+func DeleteMapping(client *gophercloud.ServiceClient, mappingID string) (r DeleteResult) {
+	resp, err := client.Delete(mappingURL(client, mappingID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListServiceProvidersOptsBuilder allows extensions to add additional
+// parameters to the ListServiceProviders request.
+type ListServiceProvidersOptsBuilder interface {
+	ToServiceProviderListQuery() (string, error)
+}
+
+// ListServiceProvidersOpts provides options to filter the
+// ListServiceProviders results.
+type ListServiceProvidersOpts struct {
+	// ID filters the response by a service provider ID.
+	ID string `q:"id"`
+
+	// Enabled filters the response by enabled service providers.
+	Enabled *bool `q:"enabled"`
+}
+
+// ToServiceProviderListQuery formats a ListServiceProvidersOpts into a query
+// string.
+func (opts ListServiceProvidersOpts) ToServiceProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListServiceProviders enumerates the service providers.
+func ListServiceProviders(client *gophercloud.ServiceClient, opts ListServiceProvidersOptsBuilder) pagination.Pager {
+	url := serviceProvidersURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceProviderPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the CreateServiceProvider request.
+type CreateServiceProviderOptsBuilder interface {
+	ToServiceProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateServiceProviderOpts provides options used to register a service
+// provider, a remote Keystone that trusts this one as identity provider.
+type CreateServiceProviderOpts struct {
+	// AuthURL is the federated authentication URL of the service provider,
+	// ending with /OS-FEDERATION/identity_providers/{idp}/protocols/saml2/auth.
+	AuthURL string `json:"auth_url" required:"true"`
+
+	// SPURL is the URL of the service provider the SAML2 assertions are
+	// posted to, such as https://sp.example.com/Shibboleth.sso/SAML2/ECP.
+	SPURL string `json:"sp_url" required:"true"`
+
+	// Description is the description of the service provider.
+	Description string `json:"description,omitempty"`
+
+	// Enabled sets the service provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML2 ECP
+	// messages sent to the service provider.
+	RelayStatePrefix string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderCreateMap formats a CreateServiceProviderOpts into a
+// create request.
+func (opts CreateServiceProviderOpts) ToServiceProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// CreateServiceProvider registers a service provider with the given ID.
+func CreateServiceProvider(client *gophercloud.ServiceClient, spID string, opts CreateServiceProviderOptsBuilder) (r CreateServiceProviderResult) {
+	b, err := opts.ToServiceProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(serviceProviderURL(client, spID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetServiceProvider retrieves details on a single service provider, by ID.
+func GetServiceProvider(client *gophercloud.ServiceClient, spID string) (r GetServiceProviderResult) {
+	resp, err := client.Get(serviceProviderURL(client, spID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateServiceProviderOptsBuilder allows extensions to add additional
+// parameters to the UpdateServiceProvider request.
+type UpdateServiceProviderOptsBuilder interface {
+	ToServiceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateServiceProviderOpts provides options used to update a service
+// provider.
+type UpdateServiceProviderOpts struct {
+	// AuthURL is the federated authentication URL of the service provider.
+	AuthURL string `json:"auth_url,omitempty"`
+
+	// SPURL is the URL of the service provider the SAML2 assertions are
+	// posted to.
+	SPURL string `json:"sp_url,omitempty"`
+
+	// Description is the description of the service provider.
+	Description *string `json:"description,omitempty"`
+
+	// Enabled sets the service provider status to enabled or disabled.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML2 ECP
+	// messages sent to the service provider.
+	RelayStatePrefix *string `json:"relay_state_prefix,omitempty"`
+}
+
+// ToServiceProviderUpdateMap formats an UpdateServiceProviderOpts into an
+// update request.
+func (opts UpdateServiceProviderOpts) ToServiceProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_provider")
+}
+
+// UpdateServiceProvider modifies the attributes of a service provider.
+func UpdateServiceProvider(client *gophercloud.ServiceClient, spID string, opts UpdateServiceProviderOptsBuilder) (r UpdateServiceProviderResult) {
+	b, err := opts.ToServiceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(serviceProviderURL(client, spID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteServiceProvider deletes a service provider.
+func DeleteServiceProvider(client *gophercloud.ServiceClient, spID string) (r DeleteResult) {
+	resp, err := client.Delete(serviceProviderURL(client, spID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListProjects enumerates the projects a federated token can be scoped to.
+// The client must be authenticated with a federated token. Use
+// projects.ExtractProjects to interpret the pages.
+func ListProjects(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, projectsURL(client), func(r pagination.PageResult) pagination.Page {
+		return projects.ProjectPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListDomains enumerates the domains a federated token can be scoped to. The
+// client must be authenticated with a federated token. Use
+// domains.ExtractDomains to interpret the pages.
+func ListDomains(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, domainsURL(client), func(r pagination.PageResult) pagination.Page {
+		return domains.DomainPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
@@ -0,0 +1,448 @@
+package federation
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// IdentityProvider represents an identity provider registered in Keystone.
+type IdentityProvider struct {
+	// ID is the unique ID of the identity provider.
+	ID string `json:"id"`
+
+	// DomainID is the ID of the domain federated users of the identity
+	// provider belong to.
+	DomainID string `json:"domain_id"`
+
+	// Description is the description of the identity provider.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the identity provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RemoteIDs are the identifiers the identity provider is known by in the
+	// assertions.
+	RemoteIDs []string `json:"remote_ids"`
+
+	// AuthorizationTTL is the number of minutes the group memberships of a
+	// federated user remain valid after their last authentication.
+	AuthorizationTTL *int `json:"authorization_ttl"`
+
+	// Links contains referencing links to the identity provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+// Protocol represents a federation protocol of an identity provider.
+type Protocol struct {
+	// ID is the ID of the protocol, such as "saml2" or "openid".
+	ID string `json:"id"`
+
+	// MappingID is the ID of the mapping applied to the assertions received
+	// with the protocol.
+	MappingID string `json:"mapping_id"`
+
+	// RemoteIDAttribute is the attribute of the assertions holding the remote
+	// ID of the identity provider.
+	RemoteIDAttribute string `json:"remote_id_attribute"`
+
+	// Links contains referencing links to the protocol.
+	Links map[string]interface{} `json:"links"`
+}
+
+// Mapping represents a set of rules mapping the attributes of the assertions
+// of an identity provider to local users, groups and projects.
+type Mapping struct {
+	// ID is the unique ID of the mapping.
+	ID string `json:"id"`
+
+	// Rules are the rules of the mapping.
+	Rules []Rule `json:"rules"`
+
+	// SchemaVersion is the version of the mapping schema of the rules.
+	SchemaVersion string `json:"schema_version"`
+
+	// Links contains referencing links to the mapping.
+	Links map[string]interface{} `json:"links"`
+}
+
+// Rule maps the assertions matching all of its Remote conditions to the
+// Local identities. In Local, "{0}" stands for the value of the first
+// Remote condition, "{1}" for the second one, and so on.
+type Rule struct {
+	// Local are the local identities the assertions are mapped to.
+	Local []LocalRule `json:"local"`
+
+	// Remote are the conditions on the attributes of the assertions.
+	Remote []RemoteRule `json:"remote"`
+}
+
+// UserType is the type of a mapped user.
+type UserType string
+
+const (
+	// UserTypeEphemeral maps the assertions to a shadow user of the domain
+	// of the identity provider. This is the default.
+	UserTypeEphemeral UserType = "ephemeral"
+
+	// UserTypeLocal maps the assertions to an existing local user.
+	UserTypeLocal UserType = "local"
+)
+
+// LocalRule is a local identity of a mapping rule.
+type LocalRule struct {
+	// User is the user the assertions are mapped to.
+	User *LocalUser `json:"user,omitempty"`
+
+	// Group is a single group the user is a member of.
+	Group *LocalGroup `json:"group,omitempty"`
+
+	// Groups is a JSON list of group names the user is a member of, in the
+	// domain Domain, usually "{0}" to use the value of a remote attribute.
+	Groups string `json:"groups,omitempty"`
+
+	// GroupIDs is a JSON list of group IDs the user is a member of.
+	GroupIDs string `json:"group_ids,omitempty"`
+
+	// Domain is the domain of Groups.
+	Domain *LocalDomain `json:"domain,omitempty"`
+
+	// Projects are the projects created for the user, with the roles the
+	// user is granted on them. They require mapping schema version 2.0.
+	Projects []LocalProject `json:"projects,omitempty"`
+}
+
+// LocalUser is the user of a local identity.
+type LocalUser struct {
+	ID     string       `json:"id,omitempty"`
+	Name   string       `json:"name,omitempty"`
+	Email  string       `json:"email,omitempty"`
+	Type   UserType     `json:"type,omitempty"`
+	Domain *LocalDomain `json:"domain,omitempty"`
+}
+
+// LocalGroup is a group of a local identity.
+type LocalGroup struct {
+	ID     string       `json:"id,omitempty"`
+	Name   string       `json:"name,omitempty"`
+	Domain *LocalDomain `json:"domain,omitempty"`
+}
+
+// LocalDomain is a domain, referred to by ID or by name.
+type LocalDomain struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// LocalProject is a project of a local identity.
+type LocalProject struct {
+	Name  string      `json:"name"`
+	Roles []LocalRole `json:"roles"`
+}
+
+// LocalRole is a role granted on a LocalProject.
+type LocalRole struct {
+	Name string `json:"name"`
+}
+
+// RemoteRule is a condition of a mapping rule on an attribute of the
+// assertions. Without AnyOneOf, NotAnyOf, Whitelist and Blacklist, the
+// condition holds as long as the attribute is present.
+type RemoteRule struct {
+	// Type is the name of the attribute.
+	Type string `json:"type"`
+
+	// AnyOneOf holds if the attribute has one of these values.
+	AnyOneOf []string `json:"any_one_of,omitempty"`
+
+	// NotAnyOf holds if the attribute has none of these values.
+	NotAnyOf []string `json:"not_any_of,omitempty"`
+
+	// Regex makes AnyOneOf and NotAnyOf regular expressions.
+	Regex bool `json:"regex,omitempty"`
+
+	// Whitelist filters the values of the attribute used in the local
+	// identities, usually group names.
+	Whitelist []string `json:"whitelist,omitempty"`
+
+	// Blacklist filters out values of the attribute used in the local
+	// identities.
+	Blacklist []string `json:"blacklist,omitempty"`
+}
+
+// ServiceProvider represents a remote Keystone that trusts this one as
+// identity provider.
+type ServiceProvider struct {
+	// ID is the unique ID of the service provider.
+	ID string `json:"id"`
+
+	// AuthURL is the federated authentication URL of the service provider.
+	AuthURL string `json:"auth_url"`
+
+	// SPURL is the URL of the service provider the SAML2 assertions are
+	// posted to.
+	SPURL string `json:"sp_url"`
+
+	// Description is the description of the service provider.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the service provider is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RelayStatePrefix is the prefix of the RelayState of the SAML2 ECP
+	// messages sent to the service provider.
+	RelayStatePrefix string `json:"relay_state_prefix"`
+
+	// Links contains referencing links to the service provider.
+	Links map[string]interface{} `json:"links"`
+}
+
+type identityProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any identity provider result as an IdentityProvider.
+func (r identityProviderResult) Extract() (*IdentityProvider, error) {
+	var s struct {
+		IdentityProvider *IdentityProvider `json:"identity_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IdentityProvider, err
+}
+
+// CreateIdentityProviderResult is the response from a CreateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type CreateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// GetIdentityProviderResult is the response from a GetIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type GetIdentityProviderResult struct {
+	identityProviderResult
+}
+
+// UpdateIdentityProviderResult is the response from an UpdateIdentityProvider
+// operation. Call its Extract method to interpret it as an IdentityProvider.
+type UpdateIdentityProviderResult struct {
+	identityProviderResult
+}
+
+type protocolResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any protocol result as a Protocol.
+func (r protocolResult) Extract() (*Protocol, error) {
+	var s struct {
+		Protocol *Protocol `json:"protocol"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Protocol, err
+}
+
+// CreateProtocolResult is the response from a CreateProtocol operation. Call
+// its Extract method to interpret it as a Protocol.
+type CreateProtocolResult struct {
+	protocolResult
+}
+
+// GetProtocolResult is the response from a GetProtocol operation. Call its
+// Extract method to interpret it as a Protocol.
+type GetProtocolResult struct {
+	protocolResult
+}
+
+// UpdateProtocolResult is the response from an UpdateProtocol operation. Call
+// its Extract method to interpret it as a Protocol.
+type UpdateProtocolResult struct {
+	protocolResult
+}
+
+type mappingResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any mapping result as a Mapping.
+func (r mappingResult) Extract() (*Mapping, error) {
+	var s struct {
+		Mapping *Mapping `json:"mapping"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Mapping, err
+}
+
+// CreateMappingResult is the response from a CreateMapping operation. Call its
+// Extract method to interpret it as a Mapping.
+type CreateMappingResult struct {
+	mappingResult
+}
+
+// GetMappingResult is the response from a GetMapping operation. Call its
+// Extract method to interpret it as a Mapping.
+type GetMappingResult struct {
+	mappingResult
+}
+
+// UpdateMappingResult is the response from an UpdateMapping operation. Call
+// its Extract method to interpret it as a Mapping.
+type UpdateMappingResult struct {
+	mappingResult
+}
+
+type serviceProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any service provider result as a ServiceProvider.
+func (r serviceProviderResult) Extract() (*ServiceProvider, error) {
+	var s struct {
+		ServiceProvider *ServiceProvider `json:"service_provider"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProvider, err
+}
+
+// CreateServiceProviderResult is the response from a CreateServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type CreateServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// GetServiceProviderResult is the response from a GetServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type GetServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// UpdateServiceProviderResult is the response from an UpdateServiceProvider
+// operation. Call its Extract method to interpret it as a ServiceProvider.
+type UpdateServiceProviderResult struct {
+	serviceProviderResult
+}
+
+// DeleteResult is the response from any Delete operation. Call its ExtractErr
+// to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// nextPageURL extracts the "next" link from the links section of a page.
+func nextPageURL(r pagination.LinkedPageBase) (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// IdentityProviderPage is a single page of IdentityProvider results.
+type IdentityProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of IdentityProviders contains any
+// results.
+func (r IdentityProviderPage) IsEmpty() (bool, error) {
+	idps, err := ExtractIdentityProviders(r)
+	return len(idps) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r IdentityProviderPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractIdentityProviders returns a slice of IdentityProviders contained in
+// a single page of results.
+func ExtractIdentityProviders(r pagination.Page) ([]IdentityProvider, error) {
+	var s struct {
+		IdentityProviders []IdentityProvider `json:"identity_providers"`
+	}
+	err := (r.(IdentityProviderPage)).ExtractInto(&s)
+	return s.IdentityProviders, err
+}
+
+// ProtocolPage is a single page of Protocol results.
+type ProtocolPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Protocols contains any results.
+func (r ProtocolPage) IsEmpty() (bool, error) {
+	protocols, err := ExtractProtocols(r)
+	return len(protocols) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ProtocolPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractProtocols returns a slice of Protocols contained in a single page of
+// results.
+func ExtractProtocols(r pagination.Page) ([]Protocol, error) {
+	var s struct {
+		Protocols []Protocol `json:"protocols"`
+	}
+	err := (r.(ProtocolPage)).ExtractInto(&s)
+	return s.Protocols, err
+}
+
+// MappingPage is a single page of Mapping results.
+type MappingPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Mappings contains any results.
+func (r MappingPage) IsEmpty() (bool, error) {
+	mappings, err := ExtractMappings(r)
+	return len(mappings) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r MappingPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractMappings returns a slice of Mappings contained in a single page of
+// results.
+func ExtractMappings(r pagination.Page) ([]Mapping, error) {
+	var s struct {
+		Mappings []Mapping `json:"mappings"`
+	}
+	err := (r.(MappingPage)).ExtractInto(&s)
+	return s.Mappings, err
+}
+
+// ServiceProviderPage is a single page of ServiceProvider results.
+type ServiceProviderPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of ServiceProviders contains any
+// results.
+func (r ServiceProviderPage) IsEmpty() (bool, error) {
+	sps, err := ExtractServiceProviders(r)
+	return len(sps) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r ServiceProviderPage) NextPageURL() (string, error) {
+	return nextPageURL(r.LinkedPageBase)
+}
+
+// ExtractServiceProviders returns a slice of ServiceProviders contained in a
+// single page of results.
+func ExtractServiceProviders(r pagination.Page) ([]ServiceProvider, error) {
+	var s struct {
+		ServiceProviders []ServiceProvider `json:"service_providers"`
+	}
+	err := (r.(ServiceProviderPage)).ExtractInto(&s)
+	return s.ServiceProviders, err
+}
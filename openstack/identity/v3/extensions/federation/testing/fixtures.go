@@ -0,0 +1,620 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/federation"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// IdentityProviderOutput provides a single identity provider.
+const IdentityProviderOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": null,
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "remote_ids": ["acme_id_1", "acme_id_2"],
+        "enabled": true,
+        "id": "ACME",
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+        }
+    }
+}
+`
+
+// ListIdentityProvidersOutput provides a single page of identity providers.
+const ListIdentityProvidersOutput = `
+{
+    "identity_providers": [
+        {
+            "authorization_ttl": null,
+            "domain_id": "1789d1",
+            "description": "Stores ACME identities",
+            "remote_ids": ["acme_id_1", "acme_id_2"],
+            "enabled": true,
+            "id": "ACME",
+            "links": {
+                "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+            }
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers"
+    }
+}
+`
+
+// CreateIdentityProviderRequest provides the input to a
+// CreateIdentityProvider request.
+const CreateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "remote_ids": ["acme_id_1", "acme_id_2"],
+        "enabled": true
+    }
+}
+`
+
+// UpdateIdentityProviderRequest provides the input to an
+// UpdateIdentityProvider request.
+const UpdateIdentityProviderRequest = `
+{
+    "identity_provider": {
+        "enabled": false,
+        "authorization_ttl": 60
+    }
+}
+`
+
+// UpdateIdentityProviderOutput provides an UpdateIdentityProvider result.
+const UpdateIdentityProviderOutput = `
+{
+    "identity_provider": {
+        "authorization_ttl": 60,
+        "domain_id": "1789d1",
+        "description": "Stores ACME identities",
+        "remote_ids": ["acme_id_1", "acme_id_2"],
+        "enabled": false,
+        "id": "ACME",
+        "links": {
+            "protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME"
+        }
+    }
+}
+`
+
+// ProtocolRequest provides the input to a CreateProtocol request.
+const ProtocolRequest = `
+{
+    "protocol": {
+        "mapping_id": "xyz234"
+    }
+}
+`
+
+// ProtocolOutput provides a single protocol.
+const ProtocolOutput = `
+{
+    "protocol": {
+        "id": "saml2",
+        "links": {
+            "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+            "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2"
+        },
+        "mapping_id": "xyz234",
+        "remote_id_attribute": ""
+    }
+}
+`
+
+// ListProtocolsOutput provides a single page of protocols.
+const ListProtocolsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols"
+    },
+    "protocols": [
+        {
+            "id": "saml2",
+            "links": {
+                "identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+                "self": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2"
+            },
+            "mapping_id": "xyz234",
+            "remote_id_attribute": ""
+        }
+    ]
+}
+`
+
+// MappingRequest provides the input to a CreateMapping request.
+const MappingRequest = `
+{
+    "mapping": {
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "groups": "{1}",
+                        "domain": {
+                            "id": "0cd5e9"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "UserName"
+                    },
+                    {
+                        "type": "orgPersonType",
+                        "not_any_of": [
+                            "Contractor",
+                            "Guest"
+                        ]
+                    }
+                ]
+            }
+        ]
+    }
+}
+`
+
+// MappingOutput provides a single mapping.
+const MappingOutput = `
+{
+    "mapping": {
+        "id": "ACME",
+        "links": {
+            "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/ACME"
+        },
+        "rules": [
+            {
+                "local": [
+                    {
+                        "user": {
+                            "name": "{0}"
+                        }
+                    },
+                    {
+                        "groups": "{1}",
+                        "domain": {
+                            "id": "0cd5e9"
+                        }
+                    }
+                ],
+                "remote": [
+                    {
+                        "type": "UserName"
+                    },
+                    {
+                        "type": "orgPersonType",
+                        "not_any_of": [
+                            "Contractor",
+                            "Guest"
+                        ]
+                    }
+                ]
+            }
+        ],
+        "schema_version": "1.0"
+    }
+}
+`
+
+// ListMappingsOutput provides a single page of mappings.
+const ListMappingsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/mappings"
+    },
+    "mappings": [
+        {
+            "id": "ACME",
+            "links": {
+                "self": "http://example.com/identity/v3/OS-FEDERATION/mappings/ACME"
+            },
+            "rules": [
+                {
+                    "local": [
+                        {
+                            "user": {
+                                "name": "{0}"
+                            }
+                        },
+                        {
+                            "groups": "{1}",
+                            "domain": {
+                                "id": "0cd5e9"
+                            }
+                        }
+                    ],
+                    "remote": [
+                        {
+                            "type": "UserName"
+                        },
+                        {
+                            "type": "orgPersonType",
+                            "not_any_of": [
+                                "Contractor",
+                                "Guest"
+                            ]
+                        }
+                    ]
+                }
+            ],
+            "schema_version": "1.0"
+        }
+    ]
+}
+`
+
+// CreateServiceProviderRequest provides the input to a CreateServiceProvider
+// request.
+const CreateServiceProviderRequest = `
+{
+    "service_provider": {
+        "auth_url": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Remote Service Provider",
+        "enabled": true,
+        "sp_url": "https://example.com/identity/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// ServiceProviderOutput provides a single service provider.
+const ServiceProviderOutput = `
+{
+    "service_provider": {
+        "auth_url": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+        "description": "Remote Service Provider",
+        "enabled": true,
+        "id": "ACME",
+        "links": {
+            "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/ACME"
+        },
+        "relay_state_prefix": "ss:mem:",
+        "sp_url": "https://example.com/identity/Shibboleth.sso/SAML2/ECP"
+    }
+}
+`
+
+// ListServiceProvidersOutput provides a single page of service providers.
+const ListServiceProvidersOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/service_providers"
+    },
+    "service_providers": [
+        {
+            "auth_url": "https://example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+            "description": "Remote Service Provider",
+            "enabled": true,
+            "id": "ACME",
+            "links": {
+                "self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/ACME"
+            },
+            "relay_state_prefix": "ss:mem:",
+            "sp_url": "https://example.com/identity/Shibboleth.sso/SAML2/ECP"
+        }
+    ]
+}
+`
+
+// UpdateServiceProviderRequest provides the input to an
+// UpdateServiceProvider request.
+const UpdateServiceProviderRequest = `
+{
+    "service_provider": {
+        "enabled": false
+    }
+}
+`
+
+// ListProjectsOutput provides a single page of projects available to a
+// federated token.
+const ListProjectsOutput = `
+{
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/projects"
+    },
+    "projects": [
+        {
+            "domain_id": "37ef61",
+            "enabled": true,
+            "id": "12d706",
+            "links": {
+                "self": "http://example.com/identity/v3/projects/12d706"
+            },
+            "name": "a project name"
+        }
+    ]
+}
+`
+
+// ListDomainsOutput provides a single page of domains available to a
+// federated token.
+const ListDomainsOutput = `
+{
+    "domains": [
+        {
+            "description": "desc of domain",
+            "enabled": true,
+            "id": "37ef61",
+            "links": {
+                "self": "http://example.com/identity/v3/domains/37ef61"
+            },
+            "name": "my domain"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/OS-FEDERATION/domains"
+    }
+}
+`
+
+// ACMEIdentityProvider is the identity provider in IdentityProviderOutput.
+var ACMEIdentityProvider = federation.IdentityProvider{
+	ID:          "ACME",
+	DomainID:    "1789d1",
+	Description: "Stores ACME identities",
+	Enabled:     true,
+	RemoteIDs:   []string{"acme_id_1", "acme_id_2"},
+	Links: map[string]interface{}{
+		"protocols": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols",
+		"self":      "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+	},
+}
+
+// SAML2Protocol is the protocol in ProtocolOutput.
+var SAML2Protocol = federation.Protocol{
+	ID:        "saml2",
+	MappingID: "xyz234",
+	Links: map[string]interface{}{
+		"identity_provider": "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME",
+		"self":              "http://example.com/identity/v3/OS-FEDERATION/identity_providers/ACME/protocols/saml2",
+	},
+}
+
+// MappingRules are the rules in MappingRequest.
+var MappingRules = []federation.Rule{
+	{
+		Local: []federation.LocalRule{
+			{
+				User: &federation.LocalUser{Name: "{0}"},
+			},
+			{
+				Groups: "{1}",
+				Domain: &federation.LocalDomain{ID: "0cd5e9"},
+			},
+		},
+		Remote: []federation.RemoteRule{
+			{
+				Type: "UserName",
+			},
+			{
+				Type:     "orgPersonType",
+				NotAnyOf: []string{"Contractor", "Guest"},
+			},
+		},
+	},
+}
+
+// ACMEMapping is the mapping in MappingOutput.
+var ACMEMapping = federation.Mapping{
+	ID:            "ACME",
+	Rules:         MappingRules,
+	SchemaVersion: "1.0",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/OS-FEDERATION/mappings/ACME",
+	},
+}
+
+// ACMEServiceProvider is the service provider in ServiceProviderOutput.
+var ACMEServiceProvider = federation.ServiceProvider{
+	ID:               "ACME",
+	AuthURL:          "https://example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+	SPURL:            "https://example.com/identity/Shibboleth.sso/SAML2/ECP",
+	Description:      "Remote Service Provider",
+	Enabled:          true,
+	RelayStatePrefix: "ss:mem:",
+	Links: map[string]interface{}{
+		"self": "https://example.com/identity/v3/OS-FEDERATION/service_providers/ACME",
+	},
+}
+
+// handleJSON sets up the test server to respond to the method on the path
+// with the status and output, after checking the request body, if any.
+func handleJSON(t *testing.T, path, method, request string, status int, output string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if request != "" {
+			th.TestJSONRequest(t, r, request)
+		}
+
+		if output == "" {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandleListIdentityProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers` on the test handler mux that responds
+// with a list of identity providers.
+func HandleListIdentityProvidersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"enabled": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, ListIdentityProvidersOutput)
+	})
+}
+
+// HandleIdentityProviderSuccessfully creates HTTP handlers at
+// `/OS-FEDERATION/identity_providers/ACME` on the test handler mux that
+// create, get, update and delete the ACME identity provider.
+func HandleIdentityProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/identity_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateIdentityProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, IdentityProviderOutput)
+		case "GET":
+			fmt.Fprint(w, IdentityProviderOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateIdentityProviderRequest)
+			fmt.Fprint(w, UpdateIdentityProviderOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleCreateProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/ACME/protocols/saml2` on the test handler
+// mux that adds the saml2 protocol to the ACME identity provider.
+func HandleCreateProtocolSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/identity_providers/ACME/protocols/saml2", "PUT", ProtocolRequest, http.StatusCreated, ProtocolOutput)
+}
+
+// HandleUpdateProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/ACME/protocols/saml2` on the test handler
+// mux that updates the saml2 protocol of the ACME identity provider.
+func HandleUpdateProtocolSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/identity_providers/ACME/protocols/saml2", "PATCH", ProtocolRequest, http.StatusOK, ProtocolOutput)
+}
+
+// HandleDeleteProtocolSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/ACME/protocols/saml2` on the test handler
+// mux that removes the saml2 protocol from the ACME identity provider.
+func HandleDeleteProtocolSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/identity_providers/ACME/protocols/saml2", "DELETE", "", http.StatusNoContent, "")
+}
+
+// HandleListProtocolsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/identity_providers/ACME/protocols` on the test handler mux
+// that responds with the protocols of the ACME identity provider.
+func HandleListProtocolsSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/identity_providers/ACME/protocols", "GET", "", http.StatusOK, ListProtocolsOutput)
+}
+
+// HandleCreateMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/ACME` on the test handler mux that creates the
+// ACME mapping.
+func HandleCreateMappingSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/mappings/ACME", "PUT", MappingRequest, http.StatusCreated, MappingOutput)
+}
+
+// HandleUpdateMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/ACME` on the test handler mux that updates the
+// rules of the ACME mapping.
+func HandleUpdateMappingSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/mappings/ACME", "PATCH", MappingRequest, http.StatusOK, MappingOutput)
+}
+
+// HandleGetMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/ACME` on the test handler mux that responds with
+// the ACME mapping.
+func HandleGetMappingSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/mappings/ACME", "GET", "", http.StatusOK, MappingOutput)
+}
+
+// HandleListMappingsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings` on the test handler mux that responds with a
+// list of mappings.
+func HandleListMappingsSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/mappings", "GET", "", http.StatusOK, ListMappingsOutput)
+}
+
+// HandleDeleteMappingSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/mappings/ACME` on the test handler mux that deletes the
+// ACME mapping.
+func HandleDeleteMappingSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/mappings/ACME", "DELETE", "", http.StatusNoContent, "")
+}
+
+// HandleServiceProviderSuccessfully creates HTTP handlers at
+// `/OS-FEDERATION/service_providers/ACME` on the test handler mux that
+// create, get, update and delete the ACME service provider.
+func HandleServiceProviderSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-FEDERATION/service_providers/ACME", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, CreateServiceProviderRequest)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, ServiceProviderOutput)
+		case "GET":
+			fmt.Fprint(w, ServiceProviderOutput)
+		case "PATCH":
+			th.TestJSONRequest(t, r, UpdateServiceProviderRequest)
+			fmt.Fprint(w, ServiceProviderOutput)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleListServiceProvidersSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/service_providers` on the test handler mux that responds
+// with a list of service providers.
+func HandleListServiceProvidersSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/service_providers", "GET", "", http.StatusOK, ListServiceProvidersOutput)
+}
+
+// HandleListProjectsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/projects` on the test handler mux that responds with the
+// projects available to the token.
+func HandleListProjectsSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/projects", "GET", "", http.StatusOK, ListProjectsOutput)
+}
+
+// HandleListDomainsSuccessfully creates an HTTP handler at
+// `/OS-FEDERATION/domains` on the test handler mux that responds with the
+// domains available to the token.
+func HandleListDomainsSuccessfully(t *testing.T) {
+	handleJSON(t, "/OS-FEDERATION/domains", "GET", "", http.StatusOK, ListDomainsOutput)
+}
@@ -0,0 +1,282 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/domains"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/federation"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/projects"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestListIdentityProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListIdentityProvidersSuccessfully(t)
+
+	enabled := true
+	count := 0
+	err := federation.ListIdentityProviders(client.ServiceClient(), federation.ListIdentityProvidersOpts{Enabled: &enabled}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := federation.ExtractIdentityProviders(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []federation.IdentityProvider{ACMEIdentityProvider}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestCreateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	enabled := true
+	createOpts := federation.CreateIdentityProviderOpts{
+		DomainID:    "1789d1",
+		Description: "Stores ACME identities",
+		Enabled:     &enabled,
+		RemoteIDs:   []string{"acme_id_1", "acme_id_2"},
+	}
+
+	actual, err := federation.CreateIdentityProvider(client.ServiceClient(), "ACME", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEIdentityProvider, *actual)
+}
+
+func TestGetIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	actual, err := federation.GetIdentityProvider(client.ServiceClient(), "ACME").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEIdentityProvider, *actual)
+}
+
+func TestUpdateIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	enabled := false
+	ttl := 60
+	updateOpts := federation.UpdateIdentityProviderOpts{
+		Enabled:          &enabled,
+		AuthorizationTTL: &ttl,
+	}
+
+	actual, err := federation.UpdateIdentityProvider(client.ServiceClient(), "ACME", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := ACMEIdentityProvider
+	expected.Enabled = false
+	expected.AuthorizationTTL = &ttl
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteIdentityProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleIdentityProviderSuccessfully(t)
+
+	err := federation.DeleteIdentityProvider(client.ServiceClient(), "ACME").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateProtocolSuccessfully(t)
+
+	actual, err := federation.CreateProtocol(client.ServiceClient(), "ACME", "saml2", federation.ProtocolOpts{MappingID: "xyz234"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SAML2Protocol, *actual)
+}
+
+func TestCreateProtocolMissingMapping(t *testing.T) {
+	res := federation.CreateProtocol(client.ServiceClient(), "ACME", "saml2", federation.ProtocolOpts{})
+	_, ok := res.Err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestUpdateProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateProtocolSuccessfully(t)
+
+	actual, err := federation.UpdateProtocol(client.ServiceClient(), "ACME", "saml2", federation.ProtocolOpts{MappingID: "xyz234"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SAML2Protocol, *actual)
+}
+
+func TestListProtocols(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProtocolsSuccessfully(t)
+
+	allPages, err := federation.ListProtocols(client.ServiceClient(), "ACME").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := federation.ExtractProtocols(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Protocol{SAML2Protocol}, actual)
+}
+
+func TestDeleteProtocol(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteProtocolSuccessfully(t)
+
+	err := federation.DeleteProtocol(client.ServiceClient(), "ACME", "saml2").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateMappingSuccessfully(t)
+
+	actual, err := federation.CreateMapping(client.ServiceClient(), "ACME", federation.MappingOpts{Rules: MappingRules}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEMapping, *actual)
+}
+
+func TestCreateMappingMissingRules(t *testing.T) {
+	res := federation.CreateMapping(client.ServiceClient(), "ACME", federation.MappingOpts{})
+	_, ok := res.Err.(gophercloud.ErrMissingInput)
+	th.CheckEquals(t, true, ok)
+}
+
+func TestGetMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetMappingSuccessfully(t)
+
+	actual, err := federation.GetMapping(client.ServiceClient(), "ACME").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEMapping, *actual)
+}
+
+func TestUpdateMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateMappingSuccessfully(t)
+
+	actual, err := federation.UpdateMapping(client.ServiceClient(), "ACME", federation.MappingOpts{Rules: MappingRules}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEMapping, *actual)
+}
+
+func TestListMappings(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListMappingsSuccessfully(t)
+
+	allPages, err := federation.ListMappings(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := federation.ExtractMappings(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.Mapping{ACMEMapping}, actual)
+}
+
+func TestDeleteMapping(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteMappingSuccessfully(t)
+
+	err := federation.DeleteMapping(client.ServiceClient(), "ACME").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	enabled := true
+	createOpts := federation.CreateServiceProviderOpts{
+		AuthURL:     "https://example.com/identity/v3/OS-FEDERATION/identity_providers/acme/protocols/saml2/auth",
+		SPURL:       "https://example.com/identity/Shibboleth.sso/SAML2/ECP",
+		Description: "Remote Service Provider",
+		Enabled:     &enabled,
+	}
+
+	actual, err := federation.CreateServiceProvider(client.ServiceClient(), "ACME", createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEServiceProvider, *actual)
+}
+
+func TestGetServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	actual, err := federation.GetServiceProvider(client.ServiceClient(), "ACME").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ACMEServiceProvider, *actual)
+}
+
+func TestUpdateServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	enabled := false
+	_, err := federation.UpdateServiceProvider(client.ServiceClient(), "ACME", federation.UpdateServiceProviderOpts{Enabled: &enabled}).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteServiceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServiceProviderSuccessfully(t)
+
+	err := federation.DeleteServiceProvider(client.ServiceClient(), "ACME").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListServiceProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListServiceProvidersSuccessfully(t)
+
+	allPages, err := federation.ListServiceProviders(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := federation.ExtractServiceProviders(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []federation.ServiceProvider{ACMEServiceProvider}, actual)
+}
+
+func TestListProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListProjectsSuccessfully(t)
+
+	allPages, err := federation.ListProjects(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.CheckEquals(t, "12d706", actual[0].ID)
+	th.CheckEquals(t, "37ef61", actual[0].DomainID)
+}
+
+func TestListDomains(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDomainsSuccessfully(t)
+
+	allPages, err := federation.ListDomains(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := domains.ExtractDomains(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.CheckEquals(t, "37ef61", actual[0].ID)
+	th.CheckEquals(t, "my domain", actual[0].Name)
+}
@@ -0,0 +1,53 @@
+package federation
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+const (
+	rootPath              = "OS-FEDERATION"
+	identityProvidersPath = "identity_providers"
+	protocolsPath         = "protocols"
+	mappingsPath          = "mappings"
+	serviceProvidersPath  = "service_providers"
+	projectsPath          = "projects"
+	domainsPath           = "domains"
+)
+
+func identityProvidersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, identityProvidersPath)
+}
+
+func identityProviderURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID)
+}
+
+func protocolsURL(c *gophercloud.ServiceClient, idpID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID, protocolsPath)
+}
+
+func protocolURL(c *gophercloud.ServiceClient, idpID, protocolID string) string {
+	return c.ServiceURL(rootPath, identityProvidersPath, idpID, protocolsPath, protocolID)
+}
+
+func mappingsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, mappingsPath)
+}
+
+func mappingURL(c *gophercloud.ServiceClient, mappingID string) string {
+	return c.ServiceURL(rootPath, mappingsPath, mappingID)
+}
+
+func serviceProvidersURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, serviceProvidersPath)
+}
+
+func serviceProviderURL(c *gophercloud.ServiceClient, spID string) string {
+	return c.ServiceURL(rootPath, serviceProvidersPath, spID)
+}
+
+func projectsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, projectsPath)
+}
+
+func domainsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, domainsPath)
+}
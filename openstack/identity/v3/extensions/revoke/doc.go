@@ -0,0 +1,37 @@
+/*
+Package revoke lists the token revocation events of the OS-REVOKE extension
+of the OpenStack Identity service. Services validating tokens themselves, for
+example from a cache, match the tokens against the events to honor the
+revocations.
+
+Example to List the Revocation Events of the Last Hour
+
+	listOpts := revoke.ListEventsOpts{
+		Since: time.Now().Add(-time.Hour),
+	}
+
+	allPages, err := revoke.ListEvents(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allEvents, err := revoke.ExtractEvents(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check Whether a Token Is Revoked
+
+	token := revoke.Token{
+		UserID:    "0ca8f6",
+		ProjectID: "263fd9",
+		AuditIDs:  []string{"VcxU2JYqT8OzfUVvrjEITQ"},
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	}
+
+	if revoke.IsRevoked(allEvents, token) {
+		fmt.Println("token is revoked")
+	}
+*/
+package revoke
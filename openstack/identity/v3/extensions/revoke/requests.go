@@ -0,0 +1,46 @@
+package revoke
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ListEventsOptsBuilder allows extensions to add additional parameters to
+// the ListEvents request.
+type ListEventsOptsBuilder interface {
+	ToRevokeListEventsQuery() (string, error)
+}
+
+// ListEventsOpts provides options to filter the ListEvents results.
+type ListEventsOpts struct {
+	// Since filters the response by the events revoked after the given time.
+	Since time.Time
+}
+
+// ToRevokeListEventsQuery formats a ListEventsOpts into a query string.
+func (opts ListEventsOpts) ToRevokeListEventsQuery() (string, error) {
+	if opts.Since.IsZero() {
+		return "", nil
+	}
+	q := url.Values{}
+	q.Set("since", opts.Since.UTC().Format(gophercloud.RFC3339Milli))
+	return "?" + q.Encode(), nil
+}
+
+// ListEvents enumerates the token revocation events.
+func ListEvents(client *gophercloud.ServiceClient, opts ListEventsOptsBuilder) pagination.Pager {
+	url := listEventsURL(client)
+	if opts != nil {
+		query, err := opts.ToRevokeListEventsQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return EventPage{pagination.SinglePageBase(r)}
+	})
+}
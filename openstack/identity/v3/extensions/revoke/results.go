@@ -0,0 +1,169 @@
+package revoke
+
+import (
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// Event is a token revocation event. It revokes the tokens issued before
+// IssuedBefore which match all of its other attributes that are set.
+type Event struct {
+	// IssuedBefore is the time before which the revoked tokens were issued.
+	IssuedBefore time.Time `json:"issued_before"`
+
+	// RevokedAt is the time at which the event was recorded.
+	RevokedAt time.Time `json:"revoked_at"`
+
+	// ExpiresAt revokes the tokens expiring at that time.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// UserID revokes the tokens of the user, and the trust tokens the user is
+	// the trustor or the trustee of.
+	UserID string `json:"user_id"`
+
+	// ProjectID revokes the tokens scoped to the project.
+	ProjectID string `json:"project_id"`
+
+	// DomainID revokes the tokens of the users of the domain and the tokens
+	// scoped to the domain or its projects.
+	DomainID string `json:"domain_id"`
+
+	// RoleID revokes the tokens granting the role.
+	RoleID string `json:"role_id"`
+
+	// TrustID revokes the tokens issued for the trust.
+	TrustID string `json:"trust_id"`
+
+	// ConsumerID revokes the tokens issued to the OAuth consumer.
+	ConsumerID string `json:"consumer_id"`
+
+	// AccessTokenID revokes the tokens issued for the OAuth access token.
+	AccessTokenID string `json:"access_token_id"`
+
+	// AuditID revokes the token with the audit ID.
+	AuditID string `json:"audit_id"`
+
+	// AuditChainID revokes the token with the audit ID, and all the tokens
+	// rescoped from it.
+	AuditChainID string `json:"audit_chain_id"`
+}
+
+// Token holds the attributes of a token that revocation events are matched
+// against.
+type Token struct {
+	// UserID is the ID of the user of the token.
+	UserID string
+
+	// UserDomainID is the ID of the domain of the user.
+	UserDomainID string
+
+	// ProjectID is the ID of the project the token is scoped to, if any.
+	ProjectID string
+
+	// ScopeDomainID is the ID of the domain the token is scoped to, or of the
+	// domain of the project the token is scoped to.
+	ScopeDomainID string
+
+	// RoleIDs are the IDs of the roles granted by the token.
+	RoleIDs []string
+
+	// TrustID, TrustorID and TrusteeID describe the trust the token was
+	// issued for, if any.
+	TrustID   string
+	TrustorID string
+	TrusteeID string
+
+	// ConsumerID and AccessTokenID describe the OAuth access token the token
+	// was issued for, if any.
+	ConsumerID    string
+	AccessTokenID string
+
+	// AuditIDs are the audit IDs of the token: its own, followed by the one of
+	// the token it was rescoped from, if any.
+	AuditIDs []string
+
+	// IssuedAt is the time at which the token was issued.
+	IssuedAt time.Time
+
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time
+}
+
+// Revokes reports whether the event revokes the token.
+func (e Event) Revokes(t Token) bool {
+	if t.IssuedAt.After(e.IssuedBefore) {
+		return false
+	}
+	if e.UserID != "" && e.UserID != t.UserID && e.UserID != t.TrustorID && e.UserID != t.TrusteeID {
+		return false
+	}
+	if e.DomainID != "" && e.DomainID != t.UserDomainID && e.DomainID != t.ScopeDomainID {
+		return false
+	}
+	if e.ProjectID != "" && e.ProjectID != t.ProjectID {
+		return false
+	}
+	if e.RoleID != "" && !contains(t.RoleIDs, e.RoleID) {
+		return false
+	}
+	if e.TrustID != "" && e.TrustID != t.TrustID {
+		return false
+	}
+	if e.ConsumerID != "" && e.ConsumerID != t.ConsumerID {
+		return false
+	}
+	if e.AccessTokenID != "" && e.AccessTokenID != t.AccessTokenID {
+		return false
+	}
+	if e.AuditID != "" && (len(t.AuditIDs) == 0 || e.AuditID != t.AuditIDs[0]) {
+		return false
+	}
+	if e.AuditChainID != "" && (len(t.AuditIDs) == 0 || e.AuditChainID != t.AuditIDs[len(t.AuditIDs)-1]) {
+		return false
+	}
+	if !e.ExpiresAt.IsZero() && !e.ExpiresAt.Truncate(time.Second).Equal(t.ExpiresAt.Truncate(time.Second)) {
+		return false
+	}
+	return true
+}
+
+// IsRevoked reports whether any of the events revokes the token.
+func IsRevoked(events []Event, t Token) bool {
+	for _, e := range events {
+		if e.Revokes(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// EventPage is a single page of Event results.
+type EventPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an EventPage contains any results.
+func (r EventPage) IsEmpty() (bool, error) {
+	events, err := ExtractEvents(r)
+	return len(events) == 0, err
+}
+
+// ExtractEvents returns a slice of Events contained in a single page of
+// results.
+func ExtractEvents(r pagination.Page) ([]Event, error) {
+	var s struct {
+		Events []Event `json:"events"`
+	}
+	err := (r.(EventPage)).ExtractInto(&s)
+	return s.Events, err
+}
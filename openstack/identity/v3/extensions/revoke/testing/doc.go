@@ -0,0 +1,2 @@
+// revoke unit tests
+package testing
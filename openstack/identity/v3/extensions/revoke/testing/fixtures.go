@@ -0,0 +1,70 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/revoke"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// ListEventsOutput provides a single page of Event results.
+const ListEventsOutput = `
+{
+    "events": [
+        {
+            "issued_before": "2014-02-27T18:30:59.000000Z",
+            "revoked_at": "2014-02-27T18:30:59.000000Z",
+            "user_id": "f287de"
+        },
+        {
+            "audit_id": "VcxU2JYqT8OzfUVvrjEITQ",
+            "issued_before": "2014-02-27T18:30:59.000000Z",
+            "revoked_at": "2014-02-27T18:30:59.000000Z"
+        }
+    ],
+    "links": {
+        "next": null,
+        "previous": null,
+        "self": "http://example.com/identity/v3/auth/tokens/OS-REVOKE/events"
+    }
+}
+`
+
+var revokedAt = time.Date(2014, 2, 27, 18, 30, 59, 0, time.UTC)
+
+// ExpectedEventsSlice is the slice of events expected to be returned from
+// ListEventsOutput.
+var ExpectedEventsSlice = []revoke.Event{
+	{
+		IssuedBefore: revokedAt,
+		RevokedAt:    revokedAt,
+		UserID:       "f287de",
+	},
+	{
+		IssuedBefore: revokedAt,
+		RevokedAt:    revokedAt,
+		AuditID:      "VcxU2JYqT8OzfUVvrjEITQ",
+	},
+}
+
+// HandleListEventsSuccessfully creates an HTTP handler at
+// `/auth/tokens/OS-REVOKE/events` on the test handler mux that responds with
+// a list of two events.
+func HandleListEventsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/tokens/OS-REVOKE/events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"since": "2014-02-27T00:00:00Z",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListEventsOutput)
+	})
+}
@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/revoke"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestListEvents(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListEventsSuccessfully(t)
+
+	listOpts := revoke.ListEventsOpts{
+		Since: time.Date(2014, 2, 27, 0, 0, 0, 0, time.UTC),
+	}
+
+	allPages, err := revoke.ListEvents(client.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := revoke.ExtractEvents(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEventsSlice, actual)
+}
+
+func TestEventRevokes(t *testing.T) {
+	issuedAt := time.Date(2014, 2, 27, 18, 0, 0, 0, time.UTC)
+	token := revoke.Token{
+		UserID:        "f287de",
+		UserDomainID:  "default",
+		ProjectID:     "263fd9",
+		ScopeDomainID: "default",
+		RoleIDs:       []string{"76e72a"},
+		TrustorID:     "8cb3e1",
+		AuditIDs:      []string{"VcxU2JYqT8OzfUVvrjEITQ", "qNUTIJntTzO1-XUk5STybw"},
+		IssuedAt:      issuedAt,
+		ExpiresAt:     issuedAt.Add(time.Hour),
+	}
+	before := issuedAt.Add(time.Minute)
+
+	revoking := []revoke.Event{
+		{IssuedBefore: before, UserID: "f287de"},
+		{IssuedBefore: before, UserID: "8cb3e1"},
+		{IssuedBefore: before, DomainID: "default"},
+		{IssuedBefore: before, ProjectID: "263fd9", RoleID: "76e72a"},
+		{IssuedBefore: before, AuditID: "VcxU2JYqT8OzfUVvrjEITQ"},
+		{IssuedBefore: before, AuditChainID: "qNUTIJntTzO1-XUk5STybw"},
+		{IssuedBefore: before, ExpiresAt: issuedAt.Add(time.Hour + time.Millisecond)},
+		{IssuedBefore: issuedAt, UserID: "f287de"},
+	}
+	for i, e := range revoking {
+		if !e.Revokes(token) {
+			t.Errorf("Expected event %d to revoke the token", i)
+		}
+	}
+
+	notRevoking := []revoke.Event{
+		{IssuedBefore: issuedAt.Add(-time.Second), UserID: "f287de"},
+		{IssuedBefore: before, UserID: "a0b2c4"},
+		{IssuedBefore: before, ProjectID: "263fd9", RoleID: "9fe2ff"},
+		{IssuedBefore: before, AuditID: "qNUTIJntTzO1-XUk5STybw"},
+		{IssuedBefore: before, TrustID: "de0945"},
+		{IssuedBefore: before, ExpiresAt: issuedAt.Add(2 * time.Hour)},
+	}
+	for i, e := range notRevoking {
+		if e.Revokes(token) {
+			t.Errorf("Expected event %d not to revoke the token", i)
+		}
+	}
+
+	th.AssertEquals(t, true, revoke.IsRevoked(append(notRevoking, revoking[0]), token))
+	th.AssertEquals(t, false, revoke.IsRevoked(notRevoking, token))
+}
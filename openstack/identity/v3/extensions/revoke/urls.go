@@ -0,0 +1,7 @@
+package revoke
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+func listEventsURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("auth", "tokens", "OS-REVOKE", "events")
+}
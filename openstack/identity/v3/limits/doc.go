@@ -0,0 +1,90 @@
+/*
+Package limits manages the project and domain limits of the OpenStack
+Identity service, known as unified limits, which override the registered
+limits of the registeredlimits package.
+
+Example to Get the Enforcement Model
+
+	model, err := limits.GetEnforcementModel(identityClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Limits
+
+	listOpts := limits.ListOpts{
+		ProjectID: "3a705b9f56bb439381b43c4fe59dccce",
+	}
+
+	allPages, err := limits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLimits, err := limits.ExtractLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, limit := range allLimits {
+		fmt.Printf("%+v\n", limit)
+	}
+
+Example to Create Limits
+
+	batchCreateOpts := limits.BatchCreateOpts{
+		limits.CreateOpts{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "snapshot",
+			ResourceLimit: 5,
+		},
+		limits.CreateOpts{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			DomainID:      "edbafc92be354ffa977c58aa79c7bdb2",
+			ResourceName:  "volume",
+			ResourceLimit: 10,
+			Description:   "Number of volumes for the domain",
+		},
+	}
+
+	createdLimits, err := limits.BatchCreate(identityClient, batchCreateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Limit
+
+	resourceLimit := 15
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+	}
+
+	limit, err := limits.Update(identityClient, "25a04c7a065c430590881c646cdcdd58", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Limit
+
+	err := limits.Delete(identityClient, "25a04c7a065c430590881c646cdcdd58").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Effective Limit of a Project
+
+	effectiveOpts := limits.EffectiveLimitOpts{
+		ProjectID:    "3a705b9f56bb439381b43c4fe59dccce",
+		ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+		ResourceName: "volume",
+	}
+
+	effective, err := limits.GetEffectiveLimit(identityClient, effectiveOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d volumes, from the %s limit %s\n", effective.ResourceLimit, effective.Source, effective.SourceID)
+*/
+package limits
@@ -0,0 +1,190 @@
+package limits
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/projects"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/registeredlimits"
+)
+
+// LimitSource tells where the limit of an EffectiveLimit comes from.
+type LimitSource string
+
+const (
+	// RegisteredLimitSource is the registered limit of the resource.
+	RegisteredLimitSource LimitSource = "registered_limit"
+
+	// ProjectLimitSource is the limit of the project itself.
+	ProjectLimitSource LimitSource = "project"
+
+	// ParentLimitSource is the limit of a parent of the project, which is
+	// lower than the one of the project under the strict-two-level model.
+	ParentLimitSource LimitSource = "parent"
+
+	// DomainLimitSource is the limit of the domain of the project, which is
+	// lower than the one of the project under the strict-two-level model.
+	DomainLimitSource LimitSource = "domain"
+)
+
+// EffectiveLimitOpts selects the project and resource GetEffectiveLimit
+// computes the limit of.
+type EffectiveLimitOpts struct {
+	// ProjectID is the ID of the project.
+	ProjectID string
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string
+
+	// RegionID is the ID of the region, for resources limited per region.
+	RegionID string
+
+	// ResourceName is the name of the resource.
+	ResourceName string
+}
+
+// EffectiveLimit is the limit that applies to a resource of a project.
+type EffectiveLimit struct {
+	// ResourceLimit is the limit of the resource.
+	ResourceLimit int
+
+	// Source tells where ResourceLimit comes from.
+	Source LimitSource
+
+	// SourceID is the ID of the registered limit, project or domain
+	// ResourceLimit comes from, as told by Source.
+	SourceID string
+
+	// Model is the enforcement model the limit was computed with.
+	Model EnforcementModelName
+}
+
+// GetEffectiveLimit computes the limit that applies to a resource of a
+// project, by merging the registered limit of the resource with the limits
+// of the project and, under the strict-two-level enforcement model, of its
+// parents and domain, which bound the limits of their children:
+//
+//   - the limit of the project overrides the registered limit;
+//   - under the strict-two-level model, the lowest limit of the parents of
+//     the project, taking the registered limit for those without a limit of
+//     their own, applies if it is lower.
+//
+// Reading the limits of other projects usually requires the admin role or a
+// system-scoped token.
+func GetEffectiveLimit(client *gophercloud.ServiceClient, opts EffectiveLimitOpts) (*EffectiveLimit, error) {
+	switch {
+	case opts.ProjectID == "":
+		return nil, gophercloud.ErrMissingInput{Argument: "ProjectID"}
+	case opts.ServiceID == "":
+		return nil, gophercloud.ErrMissingInput{Argument: "ServiceID"}
+	case opts.ResourceName == "":
+		return nil, gophercloud.ErrMissingInput{Argument: "ResourceName"}
+	}
+
+	model, err := GetEnforcementModel(client).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := projects.GetWithOpts(client, opts.ProjectID, projects.GetOpts{ParentsAsIDs: true}).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	allPages, err := registeredlimits.List(client, registeredlimits.ListOpts{
+		ServiceID:    opts.ServiceID,
+		RegionID:     opts.RegionID,
+		ResourceName: opts.ResourceName,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allRegisteredLimits, err := registeredlimits.ExtractRegisteredLimits(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var registered *registeredlimits.RegisteredLimit
+	for i := range allRegisteredLimits {
+		// Without a region filter, the registered limits of all regions are
+		// returned.
+		if allRegisteredLimits[i].RegionID == opts.RegionID {
+			registered = &allRegisteredLimits[i]
+			break
+		}
+	}
+	if registered == nil {
+		return nil, ErrRegisteredLimitNotFound{
+			ServiceID:    opts.ServiceID,
+			RegionID:     opts.RegionID,
+			ResourceName: opts.ResourceName,
+		}
+	}
+
+	allPages, err = List(client, ListOpts{
+		ServiceID:    opts.ServiceID,
+		RegionID:     opts.RegionID,
+		ResourceName: opts.ResourceName,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allLimits, err := ExtractLimits(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	projectLimits := make(map[string]int)
+	domainLimits := make(map[string]int)
+	for _, limit := range allLimits {
+		if limit.RegionID != opts.RegionID {
+			continue
+		}
+		if limit.ProjectID != "" {
+			projectLimits[limit.ProjectID] = limit.ResourceLimit
+		} else if limit.DomainID != "" {
+			domainLimits[limit.DomainID] = limit.ResourceLimit
+		}
+	}
+
+	effective := &EffectiveLimit{
+		ResourceLimit: registered.DefaultLimit,
+		Source:        RegisteredLimitSource,
+		SourceID:      registered.ID,
+		Model:         model.Name,
+	}
+	if limit, ok := projectLimits[project.ID]; ok {
+		effective.ResourceLimit = limit
+		effective.Source = ProjectLimitSource
+		effective.SourceID = project.ID
+	}
+
+	if model.Name != StrictTwoLevelModel {
+		return effective, nil
+	}
+
+	// The domain of the project may or may not be listed among its parents,
+	// depending on the version of Keystone.
+	parentIDs := project.ParentIDs()
+	if project.DomainID != "" && (len(parentIDs) == 0 || parentIDs[len(parentIDs)-1] != project.DomainID) {
+		parentIDs = append(parentIDs, project.DomainID)
+	}
+
+	for _, id := range parentIDs {
+		limit, source := registered.DefaultLimit, ParentLimitSource
+		if id == project.DomainID {
+			source = DomainLimitSource
+			if l, ok := domainLimits[id]; ok {
+				limit = l
+			}
+		} else if l, ok := projectLimits[id]; ok {
+			limit = l
+		}
+
+		if limit < effective.ResourceLimit {
+			effective.ResourceLimit = limit
+			effective.Source = source
+			effective.SourceID = id
+		}
+	}
+
+	return effective, nil
+}
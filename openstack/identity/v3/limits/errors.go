@@ -0,0 +1,29 @@
+package limits
+
+import (
+	"fmt"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// ErrRegisteredLimitNotFound is returned by GetEffectiveLimit when no
+// registered limit exists for the resource, in which case Keystone does not
+// limit it.
+type ErrRegisteredLimitNotFound struct {
+	gophercloud.BaseError
+	ServiceID    string
+	RegionID     string
+	ResourceName string
+}
+
+func (e ErrRegisteredLimitNotFound) Error() string {
+	if e.RegionID == "" {
+		e.DefaultErrString = fmt.Sprintf("No registered limit found for resource %q of service %s", e.ResourceName, e.ServiceID)
+	} else {
+		e.DefaultErrString = fmt.Sprintf("No registered limit found for resource %q of service %s in region %s", e.ResourceName, e.ServiceID, e.RegionID)
+	}
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
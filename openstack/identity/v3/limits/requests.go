@@ -0,0 +1,172 @@
+package limits
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// GetEnforcementModel retrieves the limit enforcement model of the
+// deployment.
+func GetEnforcementModel(client *gophercloud.ServiceClient) (r EnforcementModelResult) {
+	resp, err := client.Get(enforcementModelURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToLimitListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// ServiceID filters the response by a service ID.
+	ServiceID string `q:"service_id"`
+
+	// RegionID filters the response by a region ID.
+	RegionID string `q:"region_id"`
+
+	// ResourceName filters the response by a resource name.
+	ResourceName string `q:"resource_name"`
+
+	// ProjectID filters the response by a project ID.
+	ProjectID string `q:"project_id"`
+
+	// DomainID filters the response by a domain ID.
+	DomainID string `q:"domain_id"`
+}
+
+// ToLimitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLimitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the limits. Without the admin role, only the limits of the
+// project the token is scoped to are returned.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToLimitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return LimitPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// BatchCreateOptsBuilder allows extensions to add additional parameters to
+// the BatchCreate request.
+type BatchCreateOptsBuilder interface {
+	ToLimitsCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a limit. A registered limit
+// must exist for the service, region and resource.
+type CreateOpts struct {
+	// RegionID is the ID of the region the limit applies to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id" required:"true"`
+
+	// ProjectID is the ID of the project the limit applies to. Either
+	// ProjectID or DomainID must be set.
+	ProjectID string `json:"project_id,omitempty" xor:"DomainID"`
+
+	// DomainID is the ID of the domain the limit applies to.
+	DomainID string `json:"domain_id,omitempty" xor:"ProjectID"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name" required:"true"`
+
+	// ResourceLimit is the limit of the resource.
+	ResourceLimit int `json:"resource_limit"`
+
+	// Description is the description of the limit.
+	Description string `json:"description,omitempty"`
+}
+
+// BatchCreateOpts provides options used to create several limits in a single
+// request.
+type BatchCreateOpts []CreateOpts
+
+// ToLimitsCreateMap formats a BatchCreateOpts into a create request.
+func (opts BatchCreateOpts) ToLimitsCreateMap() (map[string]interface{}, error) {
+	limits := make([]map[string]interface{}, len(opts))
+	for i, limit := range opts {
+		b, err := gophercloud.BuildRequestBody(limit, "")
+		if err != nil {
+			return nil, err
+		}
+		limits[i] = b
+	}
+	return map[string]interface{}{"limits": limits}, nil
+}
+
+// BatchCreate creates new limits. Either all of them are created, or none
+// is.
+func BatchCreate(client *gophercloud.ServiceClient, opts BatchCreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLimitsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves details on a single limit, by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToLimitUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options used to update a limit.
+type UpdateOpts struct {
+	// ResourceLimit is the limit of the resource.
+	ResourceLimit *int `json:"resource_limit,omitempty"`
+
+	// Description is the description of the limit.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToLimitUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToLimitUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "limit")
+}
+
+// Update modifies the attributes of a limit.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLimitUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, id), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a limit.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,292 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/limits"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// EnforcementModelOutput provides a GetEnforcementModel result.
+const EnforcementModelOutput = `
+{
+    "model": {
+        "description": "Limit enforcement and validation does not take project hierarchy into consideration.",
+        "name": "flat"
+    }
+}
+`
+
+// ListOutput provides a single page of limits.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://10.3.150.25/identity/v3/limits",
+        "previous": null,
+        "next": null
+    },
+    "limits": [
+        {
+            "resource_name": "volume",
+            "region_id": null,
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+            },
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "domain_id": null,
+            "id": "25a04c7a065c430590881c646cdcdd58",
+            "resource_limit": 11,
+            "description": "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+        },
+        {
+            "resource_name": "snapshot",
+            "region_id": "RegionOne",
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/limits/3229b3849f584faea483d6851f7aab05"
+            },
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": null,
+            "domain_id": "edbafc92be354ffa977c58aa79c7bdb2",
+            "id": "3229b3849f584faea483d6851f7aab05",
+            "resource_limit": 5,
+            "description": null
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "limit": {
+        "resource_name": "volume",
+        "region_id": null,
+        "links": {
+            "self": "http://10.3.150.25/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        },
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "resource_limit": 11,
+        "description": "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+    }
+}
+`
+
+// CreateRequest provides the input to a BatchCreate request.
+const CreateRequest = `
+{
+    "limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+            "resource_name": "volume",
+            "resource_limit": 11,
+            "description": "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "domain_id": "edbafc92be354ffa977c58aa79c7bdb2",
+            "region_id": "RegionOne",
+            "resource_name": "snapshot",
+            "resource_limit": 5
+        }
+    ]
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "limit": {
+        "resource_limit": 5,
+        "description": "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "limit": {
+        "resource_name": "volume",
+        "region_id": null,
+        "links": {
+            "self": "http://10.3.150.25/identity/v3/limits/25a04c7a065c430590881c646cdcdd58"
+        },
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+        "domain_id": null,
+        "id": "25a04c7a065c430590881c646cdcdd58",
+        "resource_limit": 5,
+        "description": "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+    }
+}
+`
+
+// ProjectVolumeLimit is the limit in GetOutput.
+var ProjectVolumeLimit = limits.Limit{
+	ID:            "25a04c7a065c430590881c646cdcdd58",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+	ResourceName:  "volume",
+	ResourceLimit: 11,
+	Description:   "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce",
+	Links: map[string]interface{}{
+		"self": "http://10.3.150.25/identity/v3/limits/25a04c7a065c430590881c646cdcdd58",
+	},
+}
+
+// DomainSnapshotLimit is the second limit in ListOutput.
+var DomainSnapshotLimit = limits.Limit{
+	ID:            "3229b3849f584faea483d6851f7aab05",
+	ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+	DomainID:      "edbafc92be354ffa977c58aa79c7bdb2",
+	RegionID:      "RegionOne",
+	ResourceName:  "snapshot",
+	ResourceLimit: 5,
+	Links: map[string]interface{}{
+		"self": "http://10.3.150.25/identity/v3/limits/3229b3849f584faea483d6851f7aab05",
+	},
+}
+
+// ExpectedLimitsSlice is the slice of limits expected to be returned from
+// ListOutput.
+var ExpectedLimitsSlice = []limits.Limit{ProjectVolumeLimit, DomainSnapshotLimit}
+
+// HandleGetEnforcementModelSuccessfully creates an HTTP handler at
+// `/limits/model` on the test handler mux that responds with the flat
+// enforcement model.
+func HandleGetEnforcementModelSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, EnforcementModelOutput)
+	})
+}
+
+// HandleListLimitsSuccessfully creates an HTTP handler at `/limits` on the
+// test handler mux that responds with a list of limits.
+func HandleListLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"service_id": "9408080f1970482aa0e38bc2d4ea34b7"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleCreateLimitsSuccessfully creates an HTTP handler at `/limits` on the
+// test handler mux that tests limit creation.
+func HandleCreateLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// responds with a single limit.
+func HandleGetLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// tests limit updates.
+func HandleUpdateLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteLimitSuccessfully creates an HTTP handler at
+// `/limits/25a04c7a065c430590881c646cdcdd58` on the test handler mux that
+// tests limit deletion.
+func HandleDeleteLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits/25a04c7a065c430590881c646cdcdd58", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleEffectiveLimitSuccessfully creates HTTP handlers on the test handler
+// mux describing the hierarchy of the "child" project, under the "parent"
+// project, under the "top" project of the "dom" domain, with the given
+// enforcement model. The registered limit of volumes is 10, "child" has a
+// limit of 8, "parent" of 5, and "dom" of 20.
+func HandleEffectiveLimitSuccessfully(t *testing.T, model limits.EnforcementModelName) {
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"model": {"name": "%s", "description": ""}}`, model)
+	})
+
+	th.Mux.HandleFunc("/projects/child", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"parents_as_ids": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"project": {"id": "child", "domain_id": "dom", "parent_id": "parent", "parents": {"parent": {"top": {"dom": null}}}}}`)
+	})
+
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.CheckEquals(t, "volumev3", r.URL.Query().Get("service_id"))
+		th.CheckEquals(t, "volume", r.URL.Query().Get("resource_name"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"registered_limits": [
+			{"id": "other-region", "service_id": "volumev3", "region_id": "RegionTwo", "resource_name": "volume", "default_limit": 1},
+			{"id": "registered", "service_id": "volumev3", "region_id": null, "resource_name": "volume", "default_limit": 10}
+		], "links": {"next": null}}`)
+	})
+
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.CheckEquals(t, "volumev3", r.URL.Query().Get("service_id"))
+		th.CheckEquals(t, "volume", r.URL.Query().Get("resource_name"))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"limits": [
+			{"id": "l1", "service_id": "volumev3", "project_id": "child", "resource_name": "volume", "resource_limit": 8},
+			{"id": "l2", "service_id": "volumev3", "project_id": "parent", "resource_name": "volume", "resource_limit": 5},
+			{"id": "l3", "service_id": "volumev3", "domain_id": "dom", "resource_name": "volume", "resource_limit": 20},
+			{"id": "l4", "service_id": "volumev3", "region_id": "RegionTwo", "project_id": "child", "resource_name": "volume", "resource_limit": 1}
+		], "links": {"next": null}}`)
+	})
+}
@@ -0,0 +1,183 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/limits"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestGetEnforcementModel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetEnforcementModelSuccessfully(t)
+
+	model, err := limits.GetEnforcementModel(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, limits.FlatModel, model.Name)
+}
+
+func TestListLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListLimitsSuccessfully(t)
+
+	count := 0
+	listOpts := limits.ListOpts{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7"}
+	err := limits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := limits.ExtractLimits(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestBatchCreateLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateLimitsSuccessfully(t)
+
+	createOpts := limits.BatchCreateOpts{
+		limits.CreateOpts{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ResourceName:  "volume",
+			ResourceLimit: 11,
+			Description:   "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce",
+		},
+		limits.CreateOpts{
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			DomainID:      "edbafc92be354ffa977c58aa79c7bdb2",
+			RegionID:      "RegionOne",
+			ResourceName:  "snapshot",
+			ResourceLimit: 5,
+		},
+	}
+
+	actual, err := limits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLimitsSlice, actual)
+}
+
+func TestBatchCreateLimitsProjectAndDomain(t *testing.T) {
+	createOpts := limits.BatchCreateOpts{
+		limits.CreateOpts{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "volume",
+		},
+	}
+
+	_, err := createOpts.ToLimitsCreateMap()
+	th.AssertEquals(t, "Exactly one of ProjectID and DomainID must be provided", err.Error())
+}
+
+func TestGetLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetLimitSuccessfully(t)
+
+	actual, err := limits.Get(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ProjectVolumeLimit, *actual)
+}
+
+func TestUpdateLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateLimitSuccessfully(t)
+
+	resourceLimit := 5
+	description := "Number of volumes for project 3a705b9f56bb439381b43c4fe59dccce"
+	updateOpts := limits.UpdateOpts{
+		ResourceLimit: &resourceLimit,
+		Description:   &description,
+	}
+
+	actual, err := limits.Update(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := ProjectVolumeLimit
+	expected.ResourceLimit = 5
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteLimitSuccessfully(t)
+
+	err := limits.Delete(client.ServiceClient(), "25a04c7a065c430590881c646cdcdd58").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetEffectiveLimitFlat(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEffectiveLimitSuccessfully(t, limits.FlatModel)
+
+	actual, err := limits.GetEffectiveLimit(client.ServiceClient(), limits.EffectiveLimitOpts{
+		ProjectID:    "child",
+		ServiceID:    "volumev3",
+		ResourceName: "volume",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, limits.EffectiveLimit{
+		ResourceLimit: 8,
+		Source:        limits.ProjectLimitSource,
+		SourceID:      "child",
+		Model:         limits.FlatModel,
+	}, *actual)
+}
+
+func TestGetEffectiveLimitStrictTwoLevel(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEffectiveLimitSuccessfully(t, limits.StrictTwoLevelModel)
+
+	actual, err := limits.GetEffectiveLimit(client.ServiceClient(), limits.EffectiveLimitOpts{
+		ProjectID:    "child",
+		ServiceID:    "volumev3",
+		ResourceName: "volume",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, limits.EffectiveLimit{
+		ResourceLimit: 5,
+		Source:        limits.ParentLimitSource,
+		SourceID:      "parent",
+		Model:         limits.StrictTwoLevelModel,
+	}, *actual)
+}
+
+func TestGetEffectiveLimitNoRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEffectiveLimitSuccessfully(t, limits.FlatModel)
+
+	_, err := limits.GetEffectiveLimit(client.ServiceClient(), limits.EffectiveLimitOpts{
+		ProjectID:    "child",
+		ServiceID:    "volumev3",
+		RegionID:     "RegionThree",
+		ResourceName: "volume",
+	})
+	_, ok := err.(limits.ErrRegisteredLimitNotFound)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestErrRegisteredLimitNotFound(t *testing.T) {
+	err := limits.ErrRegisteredLimitNotFound{
+		ServiceID:    "volumev3",
+		RegionID:     "RegionThree",
+		ResourceName: "volume",
+	}
+	th.AssertEquals(t, `No registered limit found for resource "volume" of service volumev3 in region RegionThree`, err.Error())
+
+	err.Info = "custom message"
+	th.AssertEquals(t, "custom message", err.Error())
+}
@@ -0,0 +1,20 @@
+package limits
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+const (
+	rootPath  = "limits"
+	modelPath = "model"
+)
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
+
+func enforcementModelURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath, modelPath)
+}
@@ -85,6 +85,15 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// ListAvailable enumerates the projects the token of the client can be scoped
+// to.
+func ListAvailable(client *gophercloud.ServiceClient) pagination.Pager {
+	url := listAvailableURL(client)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ProjectPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 // Get retrieves details on a single project, by ID.
 func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 	resp, err := client.Get(getURL(client, id), &r.Body, nil)
@@ -92,6 +101,47 @@ func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
 	return
 }
 
+// GetOptsBuilder allows extensions to add additional parameters to the
+// GetWithOpts request.
+type GetOptsBuilder interface {
+	ToProjectGetQuery() (string, error)
+}
+
+// GetOpts allows to include the hierarchy of a project in the GetWithOpts
+// result.
+type GetOpts struct {
+	// ParentsAsIDs includes the IDs of the parents of the project, up to the
+	// top of the hierarchy, in the Parents field of the result.
+	ParentsAsIDs bool `q:"parents_as_ids"`
+
+	// SubtreeAsIDs includes the IDs of the projects of the subtree of the
+	// project in the Subtree field of the result.
+	SubtreeAsIDs bool `q:"subtree_as_ids"`
+}
+
+// ToProjectGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToProjectGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// GetWithOpts retrieves details on a single project, by ID, with the
+// additional information requested by opts.
+func GetWithOpts(client *gophercloud.ServiceClient, id string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, id)
+	if opts != nil {
+		query, err := opts.ToProjectGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // CreateOptsBuilder allows extensions to add additional parameters to
 // the Create request.
 type CreateOptsBuilder interface {
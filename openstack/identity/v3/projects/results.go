@@ -75,6 +75,32 @@ type Project struct {
 
 	// Options are defined options in the API to enable certain features.
 	Options map[Option]interface{} `json:"options,omitempty"`
+
+	// Parents holds the IDs of the parents of the project when requested
+	// with GetOpts.ParentsAsIDs. Each level of the hierarchy is a map from
+	// the ID of a parent to its own parents, the top of the hierarchy
+	// mapping to nil. Use ParentIDs to flatten it.
+	Parents map[string]interface{} `json:"parents,omitempty"`
+
+	// Subtree holds the IDs of the projects of the subtree of the project
+	// when requested with GetOpts.SubtreeAsIDs, in the same form as Parents.
+	Subtree map[string]interface{} `json:"subtree,omitempty"`
+}
+
+// ParentIDs returns the IDs of the parents of the project from Parents,
+// starting with the direct parent and ending with the top of the hierarchy.
+func (r Project) ParentIDs() []string {
+	var ids []string
+	level := r.Parents
+	for len(level) > 0 {
+		var next map[string]interface{}
+		for id, parents := range level {
+			ids = append(ids, id)
+			next, _ = parents.(map[string]interface{})
+		}
+		level = next
+	}
+	return ids
 }
 
 func (r *Project) UnmarshalJSON(b []byte) error {
@@ -62,6 +62,27 @@ const GetOutput = `
 }
 `
 
+// GetWithParentsOutput provides a GetWithOpts result with the IDs of the
+// parents of the project.
+const GetWithParentsOutput = `
+{
+  "project": {
+		"is_domain": false,
+		"description": "The team that is red",
+		"domain_id": "default",
+		"enabled": true,
+		"id": "1234",
+		"name": "Red Team",
+		"parent_id": "5678",
+		"parents": {
+			"5678": {
+				"9012": null
+			}
+		}
+	}
+}
+`
+
 // CreateRequest provides the input to a Create request.
 const CreateRequest = `
 {
@@ -161,6 +182,21 @@ func HandleListProjectsSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleListAvailableProjectsSuccessfully creates an HTTP handler at
+// `/auth/projects` on the test handler mux that responds with a list of two
+// projects.
+func HandleListAvailableProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/auth/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
 // HandleGetProjectSuccessfully creates an HTTP handler at `/projects` on the
 // test handler mux that responds with a single project.
 func HandleGetProjectSuccessfully(t *testing.T) {
@@ -175,6 +211,21 @@ func HandleGetProjectSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleGetProjectWithParentsSuccessfully creates an HTTP handler at
+// `/projects/1234` on the test handler mux that responds with a single project
+// and the IDs of its parents.
+func HandleGetProjectWithParentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/projects/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"parents_as_ids": "true"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetWithParentsOutput)
+	})
+}
+
 // HandleCreateProjectSuccessfully creates an HTTP handler at `/projects` on the
 // test handler mux that tests project creation.
 func HandleCreateProjectSuccessfully(t *testing.T) {
@@ -29,6 +29,19 @@ func TestListProjects(t *testing.T) {
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListAvailableProjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAvailableProjectsSuccessfully(t)
+
+	allPages, err := projects.ListAvailable(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := projects.ExtractProjects(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedProjectSlice, actual)
+}
+
 func TestListGroupsFiltersCheck(t *testing.T) {
 	type test struct {
 		filterName string
@@ -71,6 +84,18 @@ func TestGetProject(t *testing.T) {
 	th.CheckDeepEquals(t, RedTeam, *actual)
 }
 
+func TestGetProjectWithParents(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetProjectWithParentsSuccessfully(t)
+
+	actual, err := projects.GetWithOpts(client.ServiceClient(), "1234", projects.GetOpts{ParentsAsIDs: true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "5678", actual.ParentID)
+	th.CheckDeepEquals(t, []string{"5678", "9012"}, actual.ParentIDs())
+	th.CheckEquals(t, 0, len(actual.Extra))
+}
+
 func TestCreateProject(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
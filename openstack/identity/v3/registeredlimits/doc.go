@@ -0,0 +1,67 @@
+/*
+Package registeredlimits manages the registered limits of the OpenStack
+Identity service, the default limits of the resources of the services that
+apply to the projects and domains without a limit of their own. See the
+limits package for the latter.
+
+Example to List Registered Limits
+
+	listOpts := registeredlimits.ListOpts{
+		ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+	}
+
+	allPages, err := registeredlimits.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRegisteredLimits, err := registeredlimits.ExtractRegisteredLimits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, registeredLimit := range allRegisteredLimits {
+		fmt.Printf("%+v\n", registeredLimit)
+	}
+
+Example to Create Registered Limits
+
+	batchCreateOpts := registeredlimits.BatchCreateOpts{
+		registeredlimits.CreateOpts{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "snapshot",
+			DefaultLimit: 5,
+		},
+		registeredlimits.CreateOpts{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:     "RegionOne",
+			ResourceName: "volume",
+			DefaultLimit: 10,
+		},
+	}
+
+	registeredLimits, err := registeredlimits.BatchCreate(identityClient, batchCreateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Registered Limit
+
+	defaultLimit := 15
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+	}
+
+	registeredLimit, err := registeredlimits.Update(identityClient, "3229b3849f584faea483d6851f7aab05", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Registered Limit
+
+	err := registeredlimits.Delete(identityClient, "3229b3849f584faea483d6851f7aab05").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package registeredlimits
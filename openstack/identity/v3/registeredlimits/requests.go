@@ -0,0 +1,161 @@
+package registeredlimits
+
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToRegisteredLimitListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// ServiceID filters the response by a service ID.
+	ServiceID string `q:"service_id"`
+
+	// RegionID filters the response by a region ID.
+	RegionID string `q:"region_id"`
+
+	// ResourceName filters the response by a resource name.
+	ResourceName string `q:"resource_name"`
+}
+
+// ToRegisteredLimitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRegisteredLimitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the registered limits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToRegisteredLimitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RegisteredLimitPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// BatchCreateOptsBuilder allows extensions to add additional parameters to
+// the BatchCreate request.
+type BatchCreateOptsBuilder interface {
+	ToRegisteredLimitsCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a registered limit.
+type CreateOpts struct {
+	// RegionID is the ID of the region the registered limit applies to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id" required:"true"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name" required:"true"`
+
+	// DefaultLimit is the default limit of the resource.
+	DefaultLimit int `json:"default_limit"`
+
+	// Description is the description of the registered limit.
+	Description string `json:"description,omitempty"`
+}
+
+// BatchCreateOpts provides options used to create several registered limits
+// in a single request.
+type BatchCreateOpts []CreateOpts
+
+// ToRegisteredLimitsCreateMap formats a BatchCreateOpts into a create
+// request.
+func (opts BatchCreateOpts) ToRegisteredLimitsCreateMap() (map[string]interface{}, error) {
+	registeredLimits := make([]map[string]interface{}, len(opts))
+	for i, registeredLimit := range opts {
+		b, err := gophercloud.BuildRequestBody(registeredLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		registeredLimits[i] = b
+	}
+	return map[string]interface{}{"registered_limits": registeredLimits}, nil
+}
+
+// BatchCreate creates new registered limits. Either all of them are created,
+// or none is.
+func BatchCreate(client *gophercloud.ServiceClient, opts BatchCreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRegisteredLimitsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves details on a single registered limit, by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRegisteredLimitUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options used to update a registered limit. The
+// service, region and resource cannot be changed once project or domain
+// limits refer to the registered limit.
+type UpdateOpts struct {
+	// RegionID is the ID of the region the registered limit applies to.
+	RegionID string `json:"region_id,omitempty"`
+
+	// ServiceID is the ID of the service owning the resource.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// ResourceName is the name of the resource.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// DefaultLimit is the default limit of the resource.
+	DefaultLimit *int `json:"default_limit,omitempty"`
+
+	// Description is the description of the registered limit.
+	Description *string `json:"description,omitempty"`
+}
+
+// ToRegisteredLimitUpdateMap formats an UpdateOpts into an update request.
+func (opts UpdateOpts) ToRegisteredLimitUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "registered_limit")
+}
+
+// Update modifies the attributes of a registered limit.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRegisteredLimitUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Patch(resourceURL(client, id), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a registered limit.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,240 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/registeredlimits"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of registered limits.
+const ListOutput = `
+{
+    "links": {
+        "self": "http://10.3.150.25/identity/v3/registered_limits",
+        "previous": null,
+        "next": null
+    },
+    "registered_limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "region_id": null,
+            "resource_name": "snapshot",
+            "default_limit": 5,
+            "description": null,
+            "id": "3229b3849f584faea483d6851f7aab05",
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/registered_limits/3229b3849f584faea483d6851f7aab05"
+            }
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "region_id": "RegionOne",
+            "resource_name": "volume",
+            "default_limit": 10,
+            "description": "Number of volumes",
+            "id": "70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e",
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/registered_limits/70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e"
+            }
+        }
+    ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+    "registered_limit": {
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "region_id": null,
+        "resource_name": "snapshot",
+        "default_limit": 5,
+        "description": null,
+        "id": "3229b3849f584faea483d6851f7aab05",
+        "links": {
+            "self": "http://10.3.150.25/identity/v3/registered_limits/3229b3849f584faea483d6851f7aab05"
+        }
+    }
+}
+`
+
+// CreateRequest provides the input to a BatchCreate request.
+const CreateRequest = `
+{
+    "registered_limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "resource_name": "snapshot",
+            "default_limit": 5
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "region_id": "RegionOne",
+            "resource_name": "volume",
+            "default_limit": 10,
+            "description": "Number of volumes"
+        }
+    ]
+}
+`
+
+// CreateOutput provides a BatchCreate result.
+const CreateOutput = `
+{
+    "registered_limits": [
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "region_id": null,
+            "resource_name": "snapshot",
+            "default_limit": 5,
+            "description": null,
+            "id": "3229b3849f584faea483d6851f7aab05",
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/registered_limits/3229b3849f584faea483d6851f7aab05"
+            }
+        },
+        {
+            "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+            "region_id": "RegionOne",
+            "resource_name": "volume",
+            "default_limit": 10,
+            "description": "Number of volumes",
+            "id": "70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e",
+            "links": {
+                "self": "http://10.3.150.25/identity/v3/registered_limits/70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e"
+            }
+        }
+    ]
+}
+`
+
+// UpdateRequest provides the input to an Update request.
+const UpdateRequest = `
+{
+    "registered_limit": {
+        "default_limit": 15,
+        "description": "Number of snapshots"
+    }
+}
+`
+
+// UpdateOutput provides an Update result.
+const UpdateOutput = `
+{
+    "registered_limit": {
+        "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+        "region_id": null,
+        "resource_name": "snapshot",
+        "default_limit": 15,
+        "description": "Number of snapshots",
+        "id": "3229b3849f584faea483d6851f7aab05",
+        "links": {
+            "self": "http://10.3.150.25/identity/v3/registered_limits/3229b3849f584faea483d6851f7aab05"
+        }
+    }
+}
+`
+
+// SnapshotRegisteredLimit is the registered limit in GetOutput.
+var SnapshotRegisteredLimit = registeredlimits.RegisteredLimit{
+	ID:           "3229b3849f584faea483d6851f7aab05",
+	ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+	ResourceName: "snapshot",
+	DefaultLimit: 5,
+	Links: map[string]interface{}{
+		"self": "http://10.3.150.25/identity/v3/registered_limits/3229b3849f584faea483d6851f7aab05",
+	},
+}
+
+// VolumeRegisteredLimit is the second registered limit in ListOutput.
+var VolumeRegisteredLimit = registeredlimits.RegisteredLimit{
+	ID:           "70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e",
+	ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+	RegionID:     "RegionOne",
+	ResourceName: "volume",
+	DefaultLimit: 10,
+	Description:  "Number of volumes",
+	Links: map[string]interface{}{
+		"self": "http://10.3.150.25/identity/v3/registered_limits/70c8e8e2e5ba4f5b8a6b1a2d9b9f5e2e",
+	},
+}
+
+// ExpectedRegisteredLimitsSlice is the slice of registered limits expected to
+// be returned from ListOutput and CreateOutput.
+var ExpectedRegisteredLimitsSlice = []registeredlimits.RegisteredLimit{SnapshotRegisteredLimit, VolumeRegisteredLimit}
+
+// HandleListRegisteredLimitsSuccessfully creates an HTTP handler at
+// `/registered_limits` on the test handler mux that responds with a list of
+// registered limits.
+func HandleListRegisteredLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"service_id": "9408080f1970482aa0e38bc2d4ea34b7"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleCreateRegisteredLimitsSuccessfully creates an HTTP handler at
+// `/registered_limits` on the test handler mux that tests registered limit
+// creation.
+func HandleCreateRegisteredLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateOutput)
+	})
+}
+
+// HandleGetRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/3229b3849f584faea483d6851f7aab05` on the test handler
+// mux that responds with a single registered limit.
+func HandleGetRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/3229b3849f584faea483d6851f7aab05", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/3229b3849f584faea483d6851f7aab05` on the test handler
+// mux that tests registered limit updates.
+func HandleUpdateRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/3229b3849f584faea483d6851f7aab05", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteRegisteredLimitSuccessfully creates an HTTP handler at
+// `/registered_limits/3229b3849f584faea483d6851f7aab05` on the test handler
+// mux that tests registered limit deletion.
+func HandleDeleteRegisteredLimitSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/registered_limits/3229b3849f584faea483d6851f7aab05", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
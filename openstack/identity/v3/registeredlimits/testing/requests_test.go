@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/registeredlimits"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper/client"
+)
+
+func TestListRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListRegisteredLimitsSuccessfully(t)
+
+	count := 0
+	listOpts := registeredlimits.ListOpts{ServiceID: "9408080f1970482aa0e38bc2d4ea34b7"}
+	err := registeredlimits.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := registeredlimits.ExtractRegisteredLimits(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestBatchCreateRegisteredLimits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateRegisteredLimitsSuccessfully(t)
+
+	createOpts := registeredlimits.BatchCreateOpts{
+		registeredlimits.CreateOpts{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			ResourceName: "snapshot",
+			DefaultLimit: 5,
+		},
+		registeredlimits.CreateOpts{
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:     "RegionOne",
+			ResourceName: "volume",
+			DefaultLimit: 10,
+			Description:  "Number of volumes",
+		},
+	}
+
+	actual, err := registeredlimits.BatchCreate(client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRegisteredLimitsSlice, actual)
+}
+
+func TestBatchCreateRegisteredLimitsMissingInput(t *testing.T) {
+	createOpts := registeredlimits.BatchCreateOpts{
+		registeredlimits.CreateOpts{
+			ServiceID: "9408080f1970482aa0e38bc2d4ea34b7",
+		},
+	}
+
+	_, err := createOpts.ToRegisteredLimitsCreateMap()
+	th.AssertEquals(t, "Missing input for argument [ResourceName]", err.Error())
+}
+
+func TestGetRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetRegisteredLimitSuccessfully(t)
+
+	actual, err := registeredlimits.Get(client.ServiceClient(), "3229b3849f584faea483d6851f7aab05").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, SnapshotRegisteredLimit, *actual)
+}
+
+func TestUpdateRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateRegisteredLimitSuccessfully(t)
+
+	defaultLimit := 15
+	description := "Number of snapshots"
+	updateOpts := registeredlimits.UpdateOpts{
+		DefaultLimit: &defaultLimit,
+		Description:  &description,
+	}
+
+	actual, err := registeredlimits.Update(client.ServiceClient(), "3229b3849f584faea483d6851f7aab05", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := SnapshotRegisteredLimit
+	expected.DefaultLimit = 15
+	expected.Description = "Number of snapshots"
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteRegisteredLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteRegisteredLimitSuccessfully(t)
+
+	err := registeredlimits.Delete(client.ServiceClient(), "3229b3849f584faea483d6851f7aab05").ExtractErr()
+	th.AssertNoErr(t, err)
+}
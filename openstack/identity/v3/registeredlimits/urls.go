@@ -0,0 +1,13 @@
+package registeredlimits
+
+import "github.com/yogeshwargnanasekaran/gophercloud"
+
+const rootPath = "registered_limits"
+
+func rootURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(rootPath)
+}
+
+func resourceURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL(rootPath, id)
+}
@@ -131,5 +131,43 @@ Example to Unassign a Role From a User in a Project
 	if err != nil {
 		panic(err)
 	}
+
+Example to Assign a System Role to a User
+
+	userID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.AssignSystem(identityClient, roleID, roles.SystemAssignmentOpts{
+		UserID: userID,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Assign a Role to a Group on all the Projects of a Domain
+
+	domainID := "a99e9b4e620e4db09a2dfb6e42a01e66"
+	groupID := "9df1a02f5eb2416a9781e8b0c022d3ae"
+	roleID := "9fe2ff9ee4384b1894a90878d3e92bab"
+
+	err := roles.AssignInherited(identityClient, roleID, roles.AssignOpts{
+		GroupID:  groupID,
+		DomainID: domainID,
+	}).ExtractErr()
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Implied Role
+
+	priorRoleID := "7ceab6192ea34a548cc71b24f72e762c"
+	impliedRoleID := "97e2f5d38bc94842bc3da818c16762ed"
+
+	inference, err := roles.CreateImpliedRole(identityClient, priorRoleID, impliedRoleID).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package roles
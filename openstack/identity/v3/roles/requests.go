@@ -201,9 +201,24 @@ type ListAssignmentsOpts struct {
 	// UserID filterst he results by the given User ID.
 	UserID string `q:"user.id"`
 
+	// ScopeSystem filters the results by system role assignments. The only
+	// valid value is "all".
+	ScopeSystem string `q:"scope.system"`
+
+	// ScopeOSInheritInheritedTo filters the results by assignments inherited
+	// to the projects of the scope. The only valid value is "projects".
+	ScopeOSInheritInheritedTo string `q:"scope.OS-INHERIT:inherited_to"`
+
 	// Effective lists effective assignments at the user, project, and domain
 	// level, allowing for the effects of group membership.
 	Effective *bool `q:"effective"`
+
+	// IncludeNames includes the names of the entities in the results.
+	IncludeNames *bool `q:"include_names"`
+
+	// IncludeSubtree includes the assignments on the projects below the
+	// project of ScopeProjectID. Requires ScopeProjectID.
+	IncludeSubtree *bool `q:"include_subtree"`
 }
 
 // ToRolesListAssignmentsQuery formats a ListAssignmentsOpts into a query string.
@@ -295,25 +310,8 @@ func ListAssignmentsOnResource(client *gophercloud.ServiceClient, opts ListAssig
 	}
 
 	// Get corresponding URL
-	var targetID string
-	var targetType string
-	if opts.ProjectID != "" {
-		targetID = opts.ProjectID
-		targetType = "projects"
-	} else {
-		targetID = opts.DomainID
-		targetType = "domains"
-	}
-
-	var actorID string
-	var actorType string
-	if opts.UserID != "" {
-		actorID = opts.UserID
-		actorType = "users"
-	} else {
-		actorID = opts.GroupID
-		actorType = "groups"
-	}
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
 
 	url := listAssignmentsOnResourceURL(client, targetType, targetID, actorType, actorID)
 	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
@@ -332,25 +330,8 @@ func Assign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (
 	}
 
 	// Get corresponding URL
-	var targetID string
-	var targetType string
-	if opts.ProjectID != "" {
-		targetID = opts.ProjectID
-		targetType = "projects"
-	} else {
-		targetID = opts.DomainID
-		targetType = "domains"
-	}
-
-	var actorID string
-	var actorType string
-	if opts.UserID != "" {
-		actorID = opts.UserID
-		actorType = "users"
-	} else {
-		actorID = opts.GroupID
-		actorType = "groups"
-	}
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
 
 	resp, err := client.Put(assignURL(client, targetType, targetID, actorType, actorID, roleID), nil, nil, &gophercloud.RequestOpts{
 		OkCodes: []int{204},
@@ -370,29 +351,245 @@ func Unassign(client *gophercloud.ServiceClient, roleID string, opts UnassignOpt
 	}
 
 	// Get corresponding URL
-	var targetID string
-	var targetType string
-	if opts.ProjectID != "" {
-		targetID = opts.ProjectID
-		targetType = "projects"
-	} else {
-		targetID = opts.DomainID
-		targetType = "domains"
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+
+	resp, err := client.Delete(assignURL(client, targetType, targetID, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// assignmentTarget returns the URL segments of the project or domain of an
+// assignment.
+func assignmentTarget(projectID, domainID string) (string, string) {
+	if projectID != "" {
+		return "projects", projectID
+	}
+	return "domains", domainID
+}
+
+// assignmentActor returns the URL segments of the user or group of an
+// assignment.
+func assignmentActor(userID, groupID string) (string, string) {
+	if userID != "" {
+		return "users", userID
 	}
+	return "groups", groupID
+}
+
+// SystemAssignmentOpts provides options to list, check, assign and unassign
+// the system roles of a user/group.
+type SystemAssignmentOpts struct {
+	// UserID is the ID of a user
+	// Note: exactly one of UserID or GroupID must be provided
+	UserID string `xor:"GroupID"`
+
+	// GroupID is the ID of a group
+	// Note: exactly one of UserID or GroupID must be provided
+	GroupID string `xor:"UserID"`
+}
 
-	var actorID string
-	var actorType string
-	if opts.UserID != "" {
-		actorID = opts.UserID
-		actorType = "users"
-	} else {
-		actorID = opts.GroupID
-		actorType = "groups"
+// ListSystemAssignments is the operation responsible for listing the system
+// roles of a user/group.
+func ListSystemAssignments(client *gophercloud.ServiceClient, opts SystemAssignmentOpts) pagination.Pager {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return pagination.Pager{Err: err}
 	}
 
-	resp, err := client.Delete(assignURL(client, targetType, targetID, actorType, actorID, roleID), &gophercloud.RequestOpts{
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	url := systemAssignmentsURL(client, actorType, actorID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AssignSystem is the operation responsible for assigning a system role
+// to a user/group.
+func AssignSystem(client *gophercloud.ServiceClient, roleID string, opts SystemAssignmentOpts) (r AssignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Put(systemAssignURL(client, actorType, actorID, roleID), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckSystemAssignment checks whether a user/group has a system role.
+func CheckSystemAssignment(client *gophercloud.ServiceClient, roleID string, opts SystemAssignmentOpts) (r CheckAssignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Head(systemAssignURL(client, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err == nil {
+		r.isAssigned = resp.StatusCode == 204
+	}
+	return
+}
+
+// UnassignSystem is the operation responsible for unassigning a system role
+// from a user/group.
+func UnassignSystem(client *gophercloud.ServiceClient, roleID string, opts SystemAssignmentOpts) (r UnassignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Delete(systemAssignURL(client, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListInheritedAssignmentsOnResource is the operation responsible for listing
+// the roles of a user/group on a domain which are inherited to the projects
+// of the domain (OS-INHERIT).
+func ListInheritedAssignmentsOnResource(client *gophercloud.ServiceClient, opts ListAssignmentsOnResourceOpts) pagination.Pager {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	url := listInheritedAssignmentsURL(client, targetType, targetID, actorType, actorID)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AssignInherited is the operation responsible for assigning a role to a
+// user/group on a project/domain, which is inherited to all the projects
+// below it (OS-INHERIT). The role is not effective on the project/domain
+// itself.
+func AssignInherited(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Put(inheritedAssignURL(client, targetType, targetID, actorType, actorID, roleID), nil, nil, &gophercloud.RequestOpts{
 		OkCodes: []int{204},
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// CheckInheritedAssignment checks whether a user/group has an inherited role
+// on a project/domain.
+func CheckInheritedAssignment(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r CheckAssignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Head(inheritedAssignURL(client, targetType, targetID, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err == nil {
+		r.isAssigned = resp.StatusCode == 204
+	}
+	return
+}
+
+// UnassignInherited is the operation responsible for unassigning an inherited
+// role from a user/group on a project/domain.
+func UnassignInherited(client *gophercloud.ServiceClient, roleID string, opts UnassignOpts) (r UnassignmentResult) {
+	// Check xor conditions
+	_, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	targetType, targetID := assignmentTarget(opts.ProjectID, opts.DomainID)
+	actorType, actorID := assignmentActor(opts.UserID, opts.GroupID)
+	resp, err := client.Delete(inheritedAssignURL(client, targetType, targetID, actorType, actorID, roleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateImpliedRole creates an inference rule: the prior role implies the
+// implied role.
+func CreateImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	resp, err := client.Put(impliedRoleURL(client, priorRoleID, impliedRoleID), nil, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetImpliedRole retrieves an inference rule.
+func GetImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r ImpliedRoleResult) {
+	resp, err := client.Get(impliedRoleURL(client, priorRoleID, impliedRoleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CheckImpliedRole checks whether the prior role implies the implied role.
+func CheckImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r CheckImpliedRoleResult) {
+	resp, err := client.Head(impliedRoleURL(client, priorRoleID, impliedRoleID), &gophercloud.RequestOpts{
+		OkCodes: []int{204, 404},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if r.Err == nil {
+		r.isImplied = resp.StatusCode == 204
+	}
+	return
+}
+
+// DeleteImpliedRole deletes an inference rule.
+func DeleteImpliedRole(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) (r DeleteResult) {
+	resp, err := client.Delete(impliedRoleURL(client, priorRoleID, impliedRoleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListImpliedRoles retrieves the roles directly implied by the prior role.
+func ListImpliedRoles(client *gophercloud.ServiceClient, priorRoleID string) (r ListImpliedRolesResult) {
+	resp, err := client.Get(listImpliedRolesURL(client, priorRoleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListRoleInferenceRules enumerates all the inference rules.
+func ListRoleInferenceRules(client *gophercloud.ServiceClient) pagination.Pager {
+	url := listRoleInferenceRulesURL(client)
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RoleInferenceRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
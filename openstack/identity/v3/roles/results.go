@@ -139,33 +139,48 @@ type RoleAssignment struct {
 
 // AssignedRole represents a Role in an assignment.
 type AssignedRole struct {
-	ID string `json:"id,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // Scope represents a scope in a Role assignment.
 type Scope struct {
 	Domain  Domain  `json:"domain,omitempty"`
 	Project Project `json:"project,omitempty"`
+	System  System  `json:"system,omitempty"`
+
+	// OSInheritInheritedTo is "projects" if the assignment is inherited to
+	// the projects of the scope.
+	OSInheritInheritedTo string `json:"OS-INHERIT:inherited_to,omitempty"`
+}
+
+// System represents the system in a role assignment scope.
+type System struct {
+	All bool `json:"all,omitempty"`
 }
 
 // Domain represents a domain in a role assignment scope.
 type Domain struct {
-	ID string `json:"id,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // Project represents a project in a role assignment scope.
 type Project struct {
-	ID string `json:"id,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // User represents a user in a role assignment scope.
 type User struct {
-	ID string `json:"id,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // Group represents a group in a role assignment scope.
 type Group struct {
-	ID string `json:"id,omitempty"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // RoleAssignmentPage is a single page of RoleAssignments results.
@@ -212,3 +227,119 @@ type AssignmentResult struct {
 type UnassignmentResult struct {
 	gophercloud.ErrResult
 }
+
+// CheckAssignmentResult represents the result of a check operation. Call its
+// Extract method to determine whether the role is assigned.
+type CheckAssignmentResult struct {
+	isAssigned bool
+	gophercloud.Result
+}
+
+// Extract extracts CheckAssignmentResult as bool and error values.
+func (r CheckAssignmentResult) Extract() (bool, error) {
+	return r.isAssigned, r.Err
+}
+
+// InferenceRole represents a role in an inference rule.
+type InferenceRole struct {
+	// ID is the unique ID of the role.
+	ID string `json:"id"`
+
+	// Name is the role name.
+	Name string `json:"name"`
+
+	// Description is the role description.
+	Description string `json:"description"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links"`
+}
+
+// RoleInference is an inference rule between two roles: assigning the prior
+// role implicitly assigns the implied role.
+type RoleInference struct {
+	PriorRole InferenceRole `json:"prior_role"`
+	Implies   InferenceRole `json:"implies"`
+}
+
+// RoleInferenceRule groups the roles implied by a prior role.
+type RoleInferenceRule struct {
+	PriorRole InferenceRole   `json:"prior_role"`
+	Implies   []InferenceRole `json:"implies"`
+}
+
+// ImpliedRoleResult is the response from a CreateImpliedRole or
+// GetImpliedRole operation. Call its Extract method to interpret it as a
+// RoleInference.
+type ImpliedRoleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImpliedRoleResult as a RoleInference.
+func (r ImpliedRoleResult) Extract() (*RoleInference, error) {
+	var s struct {
+		RoleInference *RoleInference `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// CheckImpliedRoleResult is the response from a CheckImpliedRole operation.
+// Call its Extract method to determine whether the role is implied.
+type CheckImpliedRoleResult struct {
+	isImplied bool
+	gophercloud.Result
+}
+
+// Extract extracts CheckImpliedRoleResult as bool and error values.
+func (r CheckImpliedRoleResult) Extract() (bool, error) {
+	return r.isImplied, r.Err
+}
+
+// ListImpliedRolesResult is the response from a ListImpliedRoles operation.
+// Call its Extract method to interpret it as a RoleInferenceRule.
+type ListImpliedRolesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListImpliedRolesResult as a RoleInferenceRule.
+func (r ListImpliedRolesResult) Extract() (*RoleInferenceRule, error) {
+	var s struct {
+		RoleInference *RoleInferenceRule `json:"role_inference"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RoleInference, err
+}
+
+// RoleInferenceRulePage is a single page of RoleInferenceRule results.
+type RoleInferenceRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the RoleInferenceRulePage contains no results.
+func (r RoleInferenceRulePage) IsEmpty() (bool, error) {
+	rules, err := ExtractRoleInferenceRules(r)
+	return len(rules) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to
+// the next page of results.
+func (r RoleInferenceRulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Links.Next, err
+}
+
+// ExtractRoleInferenceRules extracts a slice of RoleInferenceRules from a
+// Collection acquired from ListRoleInferenceRules.
+func ExtractRoleInferenceRules(r pagination.Page) ([]RoleInferenceRule, error) {
+	var s struct {
+		RoleInferences []RoleInferenceRule `json:"role_inferences"`
+	}
+	err := (r.(RoleInferenceRulePage)).ExtractInto(&s)
+	return s.RoleInferences, err
+}
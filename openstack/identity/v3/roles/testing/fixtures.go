@@ -426,3 +426,312 @@ func HandleListAssignmentsOnResourceSuccessfully_DomainsGroups(t *testing.T) {
 
 	th.Mux.HandleFunc("/domains/{domain_id}/groups/{group_id}/roles", fn)
 }
+
+// ListSystemAssignmentsOutput provides a list of the system roles of a user.
+const ListSystemAssignmentsOutput = `
+{
+    "links": {
+        "self": "https://example.com/identity/v3/system/users/313233/roles",
+        "previous": null,
+        "next": null
+    },
+    "roles": [
+        {
+            "id": "9fe1d3",
+            "links": {
+                "self": "https://example.com/identity/v3/roles/9fe1d3"
+            },
+            "name": "support",
+            "extra": {
+                "description": "read-only support role"
+            }
+        }
+    ]
+}
+`
+
+// ListSystemRoleAssignmentsOutput provides a result of a role assignments
+// query filtered by the system scope.
+const ListSystemRoleAssignmentsOutput = `
+{
+    "role_assignments": [
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/system/users/313233/roles/123456"
+            },
+            "role": {
+                "id": "123456",
+                "name": "admin"
+            },
+            "scope": {
+                "system": {
+                    "all": true
+                }
+            },
+            "user": {
+                "id": "313233",
+                "name": "alice"
+            }
+        },
+        {
+            "links": {
+                "assignment": "http://identity:35357/v3/OS-INHERIT/domains/161718/users/313233/roles/123456/inherited_to_projects"
+            },
+            "role": {
+                "id": "123456",
+                "name": "admin"
+            },
+            "scope": {
+                "domain": {
+                    "id": "161718",
+                    "name": "Default"
+                },
+                "OS-INHERIT:inherited_to": "projects"
+            },
+            "user": {
+                "id": "313233",
+                "name": "alice"
+            }
+        }
+    ],
+    "links": {
+        "self": "http://identity:35357/v3/role_assignments?scope.system=all",
+        "previous": null,
+        "next": null
+    }
+}
+`
+
+// ExpectedSystemRoleAssignmentsSlice is the slice of role assignments
+// expected to be returned from ListSystemRoleAssignmentsOutput.
+var ExpectedSystemRoleAssignmentsSlice = []roles.RoleAssignment{
+	{
+		Role:  roles.AssignedRole{ID: "123456", Name: "admin"},
+		Scope: roles.Scope{System: roles.System{All: true}},
+		User:  roles.User{ID: "313233", Name: "alice"},
+	},
+	{
+		Role: roles.AssignedRole{ID: "123456", Name: "admin"},
+		Scope: roles.Scope{
+			Domain:               roles.Domain{ID: "161718", Name: "Default"},
+			OSInheritInheritedTo: "projects",
+		},
+		User: roles.User{ID: "313233", Name: "alice"},
+	},
+}
+
+// HandleListSystemRoleAssignmentsSuccessfully creates an HTTP handler at
+// `/role_assignments` on the test handler mux that responds with system and
+// inherited role assignments.
+func HandleListSystemRoleAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/role_assignments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"scope.system":  "all",
+			"include_names": "true",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListSystemRoleAssignmentsOutput)
+	})
+}
+
+// HandleSystemAssignmentsSuccessfully creates HTTP handlers for the system
+// role assignments of a user on the test handler mux.
+func HandleSystemAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/system/users/313233/roles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListSystemAssignmentsOutput)
+	})
+
+	th.Mux.HandleFunc("/system/users/313233/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/system/groups/414243/roles/9fe1d3", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+// HandleInheritedAssignmentsSuccessfully creates HTTP handlers for the
+// OS-INHERIT role assignments of a group on a domain on the test handler mux.
+func HandleInheritedAssignmentsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/OS-INHERIT/domains/161718/groups/414243/roles/inherited_to_projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListAssignmentsOnResourceOutput)
+	})
+
+	th.Mux.HandleFunc("/OS-INHERIT/domains/161718/groups/414243/roles/9fe1d3/inherited_to_projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT", "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// ImpliedRoleOutput provides a single inference rule.
+const ImpliedRoleOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "7ceab6192ea34a548cc71b24f72e762c",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+            },
+            "name": "prior role name"
+        },
+        "implies": {
+            "id": "97e2f5d38bc94842bc3da818c16762ed",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+            },
+            "name": "implied role name"
+        }
+    },
+    "links": {
+        "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed"
+    }
+}
+`
+
+// ListImpliedRolesOutput provides the roles implied by a prior role.
+const ListImpliedRolesOutput = `
+{
+    "role_inference": {
+        "prior_role": {
+            "id": "7ceab6192ea34a548cc71b24f72e762c",
+            "links": {
+                "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+            },
+            "name": "prior role name"
+        },
+        "implies": [
+            {
+                "id": "97e2f5d38bc94842bc3da818c16762ed",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+                },
+                "name": "implied role name"
+            }
+        ]
+    },
+    "links": {
+        "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c/implies"
+    }
+}
+`
+
+// ListRoleInferenceRulesOutput provides all the inference rules.
+const ListRoleInferenceRulesOutput = `
+{
+    "role_inferences": [
+        {
+            "prior_role": {
+                "id": "7ceab6192ea34a548cc71b24f72e762c",
+                "links": {
+                    "self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c"
+                },
+                "name": "prior role name"
+            },
+            "implies": [
+                {
+                    "id": "97e2f5d38bc94842bc3da818c16762ed",
+                    "links": {
+                        "self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed"
+                    },
+                    "name": "implied role name"
+                }
+            ]
+        }
+    ],
+    "links": {
+        "self": "http://example.com/identity/v3/role_inferences"
+    }
+}
+`
+
+// PriorRole is the prior role of the inference rules.
+var PriorRole = roles.InferenceRole{
+	ID:   "7ceab6192ea34a548cc71b24f72e762c",
+	Name: "prior role name",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/7ceab6192ea34a548cc71b24f72e762c",
+	},
+}
+
+// ImpliedRole is the implied role of the inference rules.
+var ImpliedRole = roles.InferenceRole{
+	ID:   "97e2f5d38bc94842bc3da818c16762ed",
+	Name: "implied role name",
+	Links: map[string]interface{}{
+		"self": "http://example.com/identity/v3/roles/97e2f5d38bc94842bc3da818c16762ed",
+	},
+}
+
+// ExpectedRoleInferenceRule is the inference rule expected to be returned
+// from ListImpliedRolesOutput.
+var ExpectedRoleInferenceRule = roles.RoleInferenceRule{
+	PriorRole: PriorRole,
+	Implies:   []roles.InferenceRole{ImpliedRole},
+}
+
+// HandleImpliedRolesSuccessfully creates HTTP handlers for the inference
+// rules on the test handler mux.
+func HandleImpliedRolesSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/roles/7ceab6192ea34a548cc71b24f72e762c/implies/97e2f5d38bc94842bc3da818c16762ed", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "PUT":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, ImpliedRoleOutput)
+		case "HEAD", "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/roles/7ceab6192ea34a548cc71b24f72e762c/implies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListImpliedRolesOutput)
+	})
+
+	th.Mux.HandleFunc("/role_inferences", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListRoleInferenceRulesOutput)
+	})
+}
@@ -294,3 +294,122 @@ func TestUnassign(t *testing.T) {
 	}).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestListSystemRoleAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSystemRoleAssignmentsSuccessfully(t)
+
+	includeNames := true
+	listOpts := roles.ListAssignmentsOpts{
+		ScopeSystem:  "all",
+		IncludeNames: &includeNames,
+	}
+
+	allPages, err := roles.ListAssignments(client.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := roles.ExtractRoleAssignments(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedSystemRoleAssignmentsSlice, actual)
+}
+
+func TestSystemAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSystemAssignmentsSuccessfully(t)
+
+	opts := roles.SystemAssignmentOpts{UserID: "313233"}
+
+	err := roles.AssignSystem(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	allPages, err := roles.ListSystemAssignments(client.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRolesOnResourceSlice, actual)
+
+	assigned, err := roles.CheckSystemAssignment(client.ServiceClient(), "9fe1d3", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, assigned)
+
+	assigned, err = roles.CheckSystemAssignment(client.ServiceClient(), "9fe1d3", roles.SystemAssignmentOpts{GroupID: "414243"}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, assigned)
+
+	err = roles.UnassignSystem(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = roles.AssignSystem(client.ServiceClient(), "9fe1d3", roles.SystemAssignmentOpts{}).ExtractErr()
+	th.AssertErr(t, err)
+}
+
+func TestInheritedAssignments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInheritedAssignmentsSuccessfully(t)
+
+	err := roles.AssignInherited(client.ServiceClient(), "9fe1d3", roles.AssignOpts{
+		GroupID:  "414243",
+		DomainID: "161718",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	allPages, err := roles.ListInheritedAssignmentsOnResource(client.ServiceClient(), roles.ListAssignmentsOnResourceOpts{
+		GroupID:  "414243",
+		DomainID: "161718",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := roles.ExtractRoles(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRolesOnResourceSlice, actual)
+
+	assigned, err := roles.CheckInheritedAssignment(client.ServiceClient(), "9fe1d3", roles.AssignOpts{
+		GroupID:  "414243",
+		DomainID: "161718",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, assigned)
+
+	err = roles.UnassignInherited(client.ServiceClient(), "9fe1d3", roles.UnassignOpts{
+		GroupID:  "414243",
+		DomainID: "161718",
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestImpliedRoles(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleImpliedRolesSuccessfully(t)
+
+	priorID := "7ceab6192ea34a548cc71b24f72e762c"
+	impliedID := "97e2f5d38bc94842bc3da818c16762ed"
+	expected := &roles.RoleInference{PriorRole: PriorRole, Implies: ImpliedRole}
+
+	inference, err := roles.CreateImpliedRole(client.ServiceClient(), priorID, impliedID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, inference)
+
+	inference, err = roles.GetImpliedRole(client.ServiceClient(), priorID, impliedID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, inference)
+
+	implied, err := roles.CheckImpliedRole(client.ServiceClient(), priorID, impliedID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, implied)
+
+	rule, err := roles.ListImpliedRoles(client.ServiceClient(), priorID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedRoleInferenceRule, *rule)
+
+	allPages, err := roles.ListRoleInferenceRules(client.ServiceClient()).AllPages()
+	th.AssertNoErr(t, err)
+	rules, err := roles.ExtractRoleInferenceRules(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []roles.RoleInferenceRule{ExpectedRoleInferenceRule}, rules)
+
+	err = roles.DeleteImpliedRole(client.ServiceClient(), priorID, impliedID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -37,3 +37,31 @@ func listAssignmentsOnResourceURL(client *gophercloud.ServiceClient, targetType,
 func assignURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string) string {
 	return client.ServiceURL(targetType, targetID, actorType, actorID, rolePath, roleID)
 }
+
+func systemAssignmentsURL(client *gophercloud.ServiceClient, actorType, actorID string) string {
+	return client.ServiceURL("system", actorType, actorID, rolePath)
+}
+
+func systemAssignURL(client *gophercloud.ServiceClient, actorType, actorID, roleID string) string {
+	return client.ServiceURL("system", actorType, actorID, rolePath, roleID)
+}
+
+func listInheritedAssignmentsURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID string) string {
+	return client.ServiceURL("OS-INHERIT", targetType, targetID, actorType, actorID, rolePath, "inherited_to_projects")
+}
+
+func inheritedAssignURL(client *gophercloud.ServiceClient, targetType, targetID, actorType, actorID, roleID string) string {
+	return client.ServiceURL("OS-INHERIT", targetType, targetID, actorType, actorID, rolePath, roleID, "inherited_to_projects")
+}
+
+func listImpliedRolesURL(client *gophercloud.ServiceClient, priorRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, "implies")
+}
+
+func impliedRoleURL(client *gophercloud.ServiceClient, priorRoleID, impliedRoleID string) string {
+	return client.ServiceURL(rolePath, priorRoleID, "implies", impliedRoleID)
+}
+
+func listRoleInferenceRulesURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("role_inferences")
+}
@@ -104,5 +104,80 @@ Example to Create a Token from a Username and Password with Project Name Scope
 		panic(err)
 	}
 
+Example to Complete Multi-Factor Authentication Step by Step
+
+	authOptions := tokens.AuthOptions{
+		UserID:   "username",
+		Password: "password",
+	}
+
+	token, err := tokens.Create(identityClient, &authOptions).ExtractToken()
+	if receiptErr, ok := err.(tokens.ErrAuthReceiptRequired); ok {
+		// receiptErr.MissingMethods() tells which methods to prompt for,
+		// such as [[totp]].
+		authOptions = tokens.AuthOptions{
+			UserID:   "username",
+			Passcode: "123456",
+			Receipt:  receiptErr.Receipt,
+		}
+
+		token, err = tokens.Create(identityClient, &authOptions).ExtractToken()
+	}
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Token from an Application Credential and a TOTP Passcode
+
+	authOptions := tokens.AuthOptions{
+		ApplicationCredentialID:     "application_credential_id",
+		ApplicationCredentialSecret: "secret",
+		UserID:                      "user_id",
+		Passcode:                    "123456",
+	}
+
+	token, err := tokens.Create(identityClient, &authOptions).ExtractToken()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Inspect a System Scoped Token Without its Catalog
+
+	result := tokens.GetWithOpts(identityClient, "token_id", tokens.GetOpts{
+		NoCatalog: true,
+	})
+
+	system, err := result.ExtractSystem()
+	if err != nil {
+		panic(err)
+	}
+
+	if system != nil && system.All {
+		fmt.Println("token is scoped to the system")
+	}
+
+Example to Validate Incoming Tokens with a Cache and Offline JWS Verification
+
+	publicKeys, err := ioutil.ReadFile("/etc/keystone/jws-keys/public/signature.pem")
+	if err != nil {
+		panic(err)
+	}
+
+	keys, err := tokens.ParseJWSPublicKeys(publicKeys)
+	if err != nil {
+		panic(err)
+	}
+
+	validator := tokens.NewValidator(identityClient, tokens.ValidatorOpts{
+		CacheTTL:      time.Minute,
+		JWSPublicKeys: keys,
+	})
+
+	token, err := validator.Validate(r.Header.Get("X-Auth-Token"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
 */
 package tokens
@@ -0,0 +1,137 @@
+package tokens
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// receiptHeader is the header auth receipts are exchanged with.
+const receiptHeader = "Openstack-Auth-Receipt"
+
+// ErrAuthReceiptRequired is returned by Create when the authentication
+// succeeded, but the multi-factor authentication rules of the user require
+// additional methods. To complete the authentication, call Create again with
+// Receipt set in the AuthOptions, along with the credentials of the missing
+// methods of one of the RequiredAuthMethods.
+type ErrAuthReceiptRequired struct {
+	gophercloud.ErrDefault401
+
+	// Receipt is the ID of the auth receipt.
+	Receipt string
+
+	// Methods are the methods the user already authenticated with.
+	Methods []string
+
+	// RequiredAuthMethods are the combinations of methods the user may
+	// authenticate with, any of which completes the authentication.
+	RequiredAuthMethods [][]string
+
+	// User is the user authenticating.
+	User User
+
+	// ExpiresAt is the time after which the receipt is no longer accepted.
+	ExpiresAt time.Time
+}
+
+func newErrAuthReceiptRequired(e gophercloud.ErrDefault401, receipt string) ErrAuthReceiptRequired {
+	err := ErrAuthReceiptRequired{
+		ErrDefault401: e,
+		Receipt:       receipt,
+	}
+
+	var s struct {
+		Receipt struct {
+			Methods   []string  `json:"methods"`
+			User      User      `json:"user"`
+			ExpiresAt time.Time `json:"expires_at"`
+		} `json:"receipt"`
+		RequiredAuthMethods [][]string `json:"required_auth_methods"`
+	}
+	// The receipt is usable even if the body cannot be interpreted.
+	if json.Unmarshal(e.Body, &s) == nil {
+		err.Methods = s.Receipt.Methods
+		err.User = s.Receipt.User
+		err.ExpiresAt = s.Receipt.ExpiresAt
+		err.RequiredAuthMethods = s.RequiredAuthMethods
+	}
+
+	return err
+}
+
+func (e ErrAuthReceiptRequired) Error() string {
+	rules := make([]string, len(e.RequiredAuthMethods))
+	for i, rule := range e.RequiredAuthMethods {
+		rules[i] = strings.Join(rule, "+")
+	}
+	return fmt.Sprintf("Additional authentication methods are required: authenticated with [%s], one of [%s] is required",
+		strings.Join(e.Methods, ", "), strings.Join(rules, ", "))
+}
+
+// MissingMethods returns, for each of the RequiredAuthMethods, the methods
+// the user has yet to authenticate with.
+func (e ErrAuthReceiptRequired) MissingMethods() [][]string {
+	done := make(map[string]bool, len(e.Methods))
+	for _, method := range e.Methods {
+		done[method] = true
+	}
+
+	missing := make([][]string, 0, len(e.RequiredAuthMethods))
+	for _, rule := range e.RequiredAuthMethods {
+		var methods []string
+		for _, method := range rule {
+			if !done[method] {
+				methods = append(methods, method)
+			}
+		}
+		missing = append(missing, methods)
+	}
+	return missing
+}
+
+// ErrInvalidToken is returned by Validator.Validate when the token is unknown
+// to Keystone, or its signature cannot be verified.
+type ErrInvalidToken struct {
+	gophercloud.BaseError
+	Reason string
+}
+
+func (e ErrInvalidToken) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Invalid token: %s", e.Reason)
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrTokenExpired is returned by Validator.Validate when the token has
+// expired.
+type ErrTokenExpired struct {
+	gophercloud.BaseError
+	ExpiresAt time.Time
+}
+
+func (e ErrTokenExpired) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Token expired at %s", e.ExpiresAt.Format(time.RFC3339))
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
+
+// ErrTokenRevoked is returned by Validator.Validate when a revocation event
+// revokes the token.
+type ErrTokenRevoked struct {
+	gophercloud.BaseError
+}
+
+func (e ErrTokenRevoked) Error() string {
+	e.DefaultErrString = "Token has been revoked"
+	if e.Info != "" {
+		return e.Info
+	}
+	return e.DefaultErrString
+}
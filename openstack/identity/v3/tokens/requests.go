@@ -1,6 +1,9 @@
 package tokens
 
-import "github.com/yogeshwargnanasekaran/gophercloud"
+import (
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+)
 
 // Scope allows a created token to be limited to a specific domain or project.
 type Scope struct {
@@ -38,9 +41,16 @@ type AuthOptions struct {
 
 	Password string `json:"password,omitempty"`
 
-	// Passcode is used in TOTP authentication method
+	// Passcode is used in TOTP authentication method. It can be combined with
+	// Password or with application credentials, in which case the user owning
+	// the application credential must be given with UserID or Username.
 	Passcode string `json:"passcode,omitempty"`
 
+	// Receipt is the auth receipt of an ErrAuthReceiptRequired error returned
+	// by a previous Create request. Only the authentication methods missing
+	// from the receipt need to be provided along with it.
+	Receipt string `json:"-"`
+
 	// At most one of DomainID and DomainName must be provided if using Username
 	// with Identity V3. Otherwise, either are optional.
 	DomainID   string `json:"-"`
@@ -73,6 +83,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		UserID:                      opts.UserID,
 		Password:                    opts.Password,
 		Passcode:                    opts.Passcode,
+		Receipt:                     opts.Receipt,
 		DomainID:                    opts.DomainID,
 		DomainName:                  opts.DomainName,
 		AllowReauth:                 opts.AllowReauth,
@@ -99,8 +110,8 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 }
 
 func (opts *AuthOptions) CanReauth() bool {
-	if opts.Passcode != "" {
-		// cannot reauth using TOTP passcode
+	if opts.Passcode != "" || opts.Receipt != "" {
+		// cannot reauth using TOTP passcode or a short-lived auth receipt
 		return false
 	}
 
@@ -108,9 +119,12 @@ func (opts *AuthOptions) CanReauth() bool {
 }
 
 // ToTokenV3HeadersMap allows AuthOptions to satisfy the AuthOptionsBuilder
-// interface in the v3 tokens package.
+// interface in the v3 tokens package. It sends the auth receipt, if any.
 func (opts *AuthOptions) ToTokenV3HeadersMap(map[string]interface{}) (map[string]string, error) {
-	return nil, nil
+	if opts.Receipt == "" {
+		return nil, nil
+	}
+	return map[string]string{receiptHeader: opts.Receipt}, nil
 }
 
 func subjectTokenHeaders(subjectToken string) map[string]string {
@@ -121,6 +135,11 @@ func subjectTokenHeaders(subjectToken string) map[string]string {
 
 // Create authenticates and either generates a new token, or changes the Scope
 // of an existing token.
+//
+// If the user must authenticate with additional methods, as required by
+// multi-factor authentication rules, an ErrAuthReceiptRequired error is
+// returned. Create can then be called again with the receipt and the missing
+// methods.
 func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r CreateResult) {
 	scope, err := opts.ToTokenV3ScopeMap()
 	if err != nil {
@@ -134,10 +153,29 @@ func Create(c *gophercloud.ServiceClient, opts AuthOptionsBuilder) (r CreateResu
 		return
 	}
 
+	h, err := opts.ToTokenV3HeadersMap(map[string]interface{}{
+		"method": "POST",
+		"url":    tokenURL(c),
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	headers := map[string]string{"X-Auth-Token": ""}
+	for k, v := range h {
+		headers[k] = v
+	}
+
 	resp, err := c.Post(tokenURL(c), b, &r.Body, &gophercloud.RequestOpts{
-		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		MoreHeaders: headers,
 	})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	if e, ok := r.Err.(gophercloud.ErrDefault401); ok {
+		if receipt := e.ResponseHeader.Get(receiptHeader); receipt != "" {
+			r.Err = newErrAuthReceiptRequired(e, receipt)
+		}
+	}
 	return
 }
 
@@ -151,6 +189,47 @@ func Get(c *gophercloud.ServiceClient, token string) (r GetResult) {
 	return
 }
 
+// GetOptsBuilder allows extensions to add additional parameters to the
+// GetWithOpts request.
+type GetOptsBuilder interface {
+	ToTokenGetQuery() (string, error)
+}
+
+// GetOpts allows to tune the token GetWithOpts request.
+type GetOpts struct {
+	// NoCatalog omits the service catalog from the response.
+	NoCatalog bool `q:"nocatalog"`
+
+	// AllowExpired allows to retrieve recently expired tokens.
+	AllowExpired bool `q:"allow_expired"`
+}
+
+// ToTokenGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToTokenGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// GetWithOpts validates and retrieves information about another token, as
+// Get does, with additional query parameters.
+func GetWithOpts(c *gophercloud.ServiceClient, token string, opts GetOptsBuilder) (r GetResult) {
+	url := tokenURL(c)
+	if opts != nil {
+		query, err := opts.ToTokenGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := c.Get(url, &r.Body, &gophercloud.RequestOpts{
+		MoreHeaders: subjectTokenHeaders(token),
+		OkCodes:     []int{200, 203},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // Validate determines if a specified token is valid or not.
 func Validate(c *gophercloud.ServiceClient, token string) (bool, error) {
 	resp, err := c.Head(tokenURL(c), &gophercloud.RequestOpts{
@@ -172,3 +251,12 @@ func Revoke(c *gophercloud.ServiceClient, token string) (r RevokeResult) {
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// ListAvailableSystems enumerates the systems the token of the client can be
+// scoped to.
+func ListAvailableSystems(c *gophercloud.ServiceClient) pagination.Pager {
+	url := availableSystemsURL(c)
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SystemPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
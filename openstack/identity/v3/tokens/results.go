@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
 )
 
 // Endpoint represents a single API endpoint offered by a service.
@@ -76,6 +77,51 @@ type Project struct {
 	Name   string `json:"name"`
 }
 
+// System provides information about the system to which this token grants
+// access.
+type System struct {
+	All bool `json:"all"`
+}
+
+// SystemPage is a single page of System results.
+type SystemPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the SystemPage contains no results.
+func (r SystemPage) IsEmpty() (bool, error) {
+	systems, err := ExtractSystems(r)
+	return len(systems) == 0, err
+}
+
+// ExtractSystems extracts a slice of Systems from a page acquired from
+// ListAvailableSystems.
+func ExtractSystems(r pagination.Page) ([]System, error) {
+	var s struct {
+		Systems []System `json:"system"`
+	}
+	err := (r.(SystemPage)).ExtractInto(&s)
+	return s.Systems, err
+}
+
+// AccessRule restricts the requests an application credential may be used
+// for.
+type AccessRule struct {
+	ID      string `json:"id"`
+	Path    string `json:"path"`
+	Method  string `json:"method"`
+	Service string `json:"service"`
+}
+
+// ApplicationCredential provides information about the application
+// credential the token was issued for.
+type ApplicationCredential struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Restricted  bool         `json:"restricted"`
+	AccessRules []AccessRule `json:"access_rules"`
+}
+
 // commonResult is the response from a request. A commonResult has various
 // methods which can be used to extract different details about the result.
 type commonResult struct {
@@ -116,8 +162,19 @@ func (r GetResult) ExtractTokenID() (string, error) {
 	return r.Header.Get("X-Subject-Token"), r.Err
 }
 
+// ExtractExpiresAt returns the time at which the token expires. It allows
+// gophercloud.ProviderClient to refresh the token before it expires.
+func (r commonResult) ExtractExpiresAt() (time.Time, error) {
+	token, err := r.ExtractToken()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return token.ExpiresAt, nil
+}
+
 // ExtractServiceCatalog returns the ServiceCatalog that was generated along
-// with the user's Token.
+// with the user's Token. The catalog has no entries if the token was issued
+// or retrieved without a catalog, see GetOpts.NoCatalog.
 func (r commonResult) ExtractServiceCatalog() (*ServiceCatalog, error) {
 	var s ServiceCatalog
 	err := r.ExtractInto(&s)
@@ -160,6 +217,57 @@ func (r commonResult) ExtractDomain() (*Domain, error) {
 	return s.Domain, err
 }
 
+// ExtractSystem returns the System to which User is authorized, or nil if the
+// token is not system scoped.
+func (r commonResult) ExtractSystem() (*System, error) {
+	var s struct {
+		System *System `json:"system"`
+	}
+	err := r.ExtractInto(&s)
+	return s.System, err
+}
+
+// ExtractIsDomain returns whether the Project to which User is authorized is
+// a domain acting as a project.
+func (r commonResult) ExtractIsDomain() (bool, error) {
+	var s struct {
+		IsDomain bool `json:"is_domain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IsDomain, err
+}
+
+// ExtractApplicationCredential returns the ApplicationCredential the token was
+// issued for, including its access rules, or nil if the token was not issued
+// for an application credential.
+func (r commonResult) ExtractApplicationCredential() (*ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredential *ApplicationCredential `json:"application_credential"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ApplicationCredential, err
+}
+
+// ExtractMethods returns the authentication methods the token was issued
+// with.
+func (r commonResult) ExtractMethods() ([]string, error) {
+	var s struct {
+		Methods []string `json:"methods"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Methods, err
+}
+
+// ExtractAuditIDs returns the audit IDs of the token. The first is the ID of
+// the token, the second, if any, is the ID of the token it was rescoped from.
+func (r commonResult) ExtractAuditIDs() ([]string, error) {
+	var s struct {
+		AuditIDs []string `json:"audit_ids"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AuditIDs, err
+}
+
 // CreateResult is the response from a Create request. Use ExtractToken()
 // to interpret it as a Token, or ExtractServiceCatalog() to interpret it
 // as a service catalog.
@@ -2,6 +2,7 @@ package testing
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -304,6 +305,67 @@ var ExpectedDomain = tokens.Domain{
 	Name: "Default",
 }
 
+// SystemToken is a sample response to a token validation with nocatalog for
+// a system scoped token issued for an application credential.
+const SystemToken = `
+{
+    "token": {
+        "audit_ids": ["VcxU2JYqT8OzfUVvrjEITQ", "qNUTIJntTzO1-XUk5STybw"],
+        "expires_at": "2017-06-03T02:19:49.000000Z",
+        "is_domain": false,
+        "issued_at": "2017-06-03T01:19:49.000000Z",
+        "methods": ["application_credential"],
+        "application_credential": {
+            "id": "c8d1a0a2d43a4e1b8f3f6b3c2c2e1d0f",
+            "name": "monitoring",
+            "restricted": true,
+            "access_rules": [
+                {
+                    "id": "07d719df00f349ef8de77d542edf010c",
+                    "path": "/v2.1/servers",
+                    "method": "GET",
+                    "service": "compute"
+                }
+            ]
+        },
+        "roles": [
+            {
+                "id": "434426788d5a451faf763b0e6db5aefb",
+                "name": "admin"
+            }
+        ],
+        "system": {
+            "all": true
+        },
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "0fe63b5307ba4c20ae5c4c9e0f21dd42",
+            "name": "admin",
+            "password_expires_at": null
+        }
+    }
+}
+`
+
+// ExpectedApplicationCredential contains expected application credential
+// extracted from SystemToken.
+var ExpectedApplicationCredential = tokens.ApplicationCredential{
+	ID:         "c8d1a0a2d43a4e1b8f3f6b3c2c2e1d0f",
+	Name:       "monitoring",
+	Restricted: true,
+	AccessRules: []tokens.AccessRule{
+		{
+			ID:      "07d719df00f349ef8de77d542edf010c",
+			Path:    "/v2.1/servers",
+			Method:  "GET",
+			Service: "compute",
+		},
+	},
+}
+
 func getGetResult(t *testing.T) tokens.GetResult {
 	result := tokens.GetResult{}
 	result.Header = http.Header{
@@ -323,3 +385,80 @@ func getGetDomainResult(t *testing.T) tokens.GetResult {
 	testhelper.AssertNoErr(t, err)
 	return result
 }
+
+func getGetSystemResult(t *testing.T) tokens.GetResult {
+	result := tokens.GetResult{}
+	result.Header = http.Header{
+		"X-Subject-Token": []string{testTokenID},
+	}
+	err := json.Unmarshal([]byte(SystemToken), &result.Body)
+	testhelper.AssertNoErr(t, err)
+	return result
+}
+
+// AuthReceiptOutput is a sample response to an authentication request that
+// requires additional methods.
+const AuthReceiptOutput = `
+{
+    "receipt": {
+        "methods": ["password"],
+        "user": {
+            "domain": {"id": "default", "name": "Default"},
+            "id": "ee4dfb6e5540447cb3741905149d9b6e",
+            "name": "admin"
+        },
+        "expires_at": "2018-07-05T02:32:44.000000Z",
+        "issued_at": "2018-07-05T02:27:44.000000Z"
+    },
+    "required_auth_methods": [
+        ["password", "totp"],
+        ["password", "custom-auth-method"]
+    ]
+}
+`
+
+// HandleAuthReceiptRequired sets up the test server to request a TOTP
+// passcode from a user authenticated with a password, and to issue a token
+// once the passcode is sent along with the receipt.
+func HandleAuthReceiptRequired(t *testing.T) {
+	const receipt = "gAAAAABbfaTtZhg2ZnYSm0cMWYAx-qcmf9q5kqT1ox5wSzVYTBhJ_VUG6bDIy-CygjaZg7cdZyS9pBx8w61GW0qT-wp4c7F7Eq6YDivr3JqSQ10dXqEcR3aRoSLWAqIHHUNI3g-TmBM3SuEgQ1cJYwVjPA9fxUH6K1y-CKCU9uEo2j1ZOHAWEvc"
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+
+		if r.Header.Get("Openstack-Auth-Receipt") == "" {
+			testhelper.TestJSONRequest(t, r, `{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": {"id": "ee4dfb6e5540447cb3741905149d9b6e", "password": "devstacker"}
+						}
+					}
+				}
+			}`)
+
+			w.Header().Set("Openstack-Auth-Receipt", receipt)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, AuthReceiptOutput)
+			return
+		}
+
+		testhelper.TestHeader(t, r, "Openstack-Auth-Receipt", receipt)
+		testhelper.TestJSONRequest(t, r, `{
+			"auth": {
+				"identity": {
+					"methods": ["totp"],
+					"totp": {
+						"user": {"id": "ee4dfb6e5540447cb3741905149d9b6e", "passcode": "123456"}
+					}
+				}
+			}
+		}`)
+
+		w.Header().Set("X-Subject-Token", "3e2e7e1e1e5b4e0f9d4f7d6c1a2b3c4d")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, TokenOutput)
+	})
+}
@@ -342,6 +342,72 @@ func TestCreateApplicationCredentialNameAndSecret(t *testing.T) {
 	`)
 }
 
+func TestCreateApplicationCredentialIDAndTOTP(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret", UserID: "someuser", Passcode: "12345678"}
+	authTokenPost(t, options, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"totp": {
+						"user": {
+							"id": "someuser",
+							"passcode": "12345678"
+						}
+					},
+					"methods": [
+						"application_credential",
+						"totp"
+					]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialTOTPMissingUser(t *testing.T) {
+	options := tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret", Passcode: "12345678"}
+	authTokenPostErr(t, options, nil, false, gophercloud.ErrUsernameOrUserID{})
+}
+
+func TestCreateAuthReceiptRequired(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleAuthReceiptRequired(t)
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       testhelper.Endpoint(),
+	}
+
+	options := tokens.AuthOptions{UserID: "ee4dfb6e5540447cb3741905149d9b6e", Password: "devstacker", AllowReauth: true}
+	err := tokens.Create(&client, &options).Err
+
+	receiptErr, ok := err.(tokens.ErrAuthReceiptRequired)
+	if !ok {
+		t.Fatalf("Create returned an unexpected error: %v", err)
+	}
+	testhelper.CheckEquals(t, "gAAAAABbfaTtZhg2ZnYSm0cMWYAx-qcmf9q5kqT1ox5wSzVYTBhJ_VUG6bDIy-CygjaZg7cdZyS9pBx8w61GW0qT-wp4c7F7Eq6YDivr3JqSQ10dXqEcR3aRoSLWAqIHHUNI3g-TmBM3SuEgQ1cJYwVjPA9fxUH6K1y-CKCU9uEo2j1ZOHAWEvc", receiptErr.Receipt)
+	testhelper.CheckDeepEquals(t, []string{"password"}, receiptErr.Methods)
+	testhelper.CheckDeepEquals(t, [][]string{{"password", "totp"}, {"password", "custom-auth-method"}}, receiptErr.RequiredAuthMethods)
+	testhelper.CheckDeepEquals(t, [][]string{{"totp"}, {"custom-auth-method"}}, receiptErr.MissingMethods())
+	testhelper.CheckEquals(t, "ee4dfb6e5540447cb3741905149d9b6e", receiptErr.User.ID)
+	testhelper.CheckEquals(t, time.Date(2018, 7, 5, 2, 32, 44, 0, time.UTC), receiptErr.ExpiresAt)
+	testhelper.CheckEquals(t, http.StatusUnauthorized, receiptErr.Actual)
+
+	// The next step sends the receipt along with the missing method only,
+	// and cannot be replayed.
+	options = tokens.AuthOptions{UserID: "ee4dfb6e5540447cb3741905149d9b6e", Passcode: "123456", Receipt: receiptErr.Receipt, AllowReauth: true}
+	testhelper.CheckEquals(t, false, options.CanReauth())
+
+	token, err := tokens.Create(&client, &options).ExtractToken()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "3e2e7e1e1e5b4e0f9d4f7d6c1a2b3c4d", token.ID)
+}
+
 func TestCreateTOTPProjectNameAndDomainNameScope(t *testing.T) {
 	options := tokens.AuthOptions{UserID: "someuser", Passcode: "12345678"}
 	scope := &tokens.Scope{ProjectName: "world-domination", DomainName: "evil-plans"}
@@ -578,6 +644,71 @@ func TestGetRequest(t *testing.T) {
 	}
 }
 
+func TestGetWithOptsRequest(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			TokenID: "12345abcdef",
+		},
+		Endpoint: testhelper.Endpoint(),
+	}
+
+	testhelper.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", "12345abcdef")
+		testhelper.TestHeader(t, r, "X-Subject-Token", "abcdef12345")
+		testhelper.TestFormValues(t, r, map[string]string{"nocatalog": "true"})
+
+		w.Header().Add("X-Subject-Token", "abcdef12345")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, SystemToken)
+	})
+
+	result := tokens.GetWithOpts(&client, "abcdef12345", tokens.GetOpts{NoCatalog: true})
+	system, err := result.ExtractSystem()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, system.All)
+
+	tokenID, err := result.ExtractTokenID()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, "abcdef12345", tokenID)
+}
+
+func TestListAvailableSystems(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/auth/system", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", "12345abcdef")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+			{
+				"system": [{"all": true}],
+				"links": {"self": "https://example.com/identity/v3/auth/system", "previous": null, "next": null}
+			}
+		`)
+	})
+
+	client := gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{
+			TokenID: "12345abcdef",
+		},
+		Endpoint: testhelper.Endpoint(),
+	}
+
+	allPages, err := tokens.ListAvailableSystems(&client).AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	systems, err := tokens.ExtractSystems(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []tokens.System{{All: true}}, systems)
+}
+
 func prepareAuthTokenHandler(t *testing.T, expectedMethod string, status int) gophercloud.ServiceClient {
 	client := gophercloud.ServiceClient{
 		ProviderClient: &gophercloud.ProviderClient{
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
 	"github.com/yogeshwargnanasekaran/gophercloud/testhelper"
 )
 
@@ -59,3 +60,43 @@ func TestExtractDomain(t *testing.T) {
 
 	testhelper.CheckDeepEquals(t, &ExpectedDomain, domain)
 }
+
+func TestExtractSystem(t *testing.T) {
+	result := getGetSystemResult(t)
+
+	system, err := result.ExtractSystem()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, &tokens.System{All: true}, system)
+
+	isDomain, err := result.ExtractIsDomain()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, false, isDomain)
+
+	project, err := result.ExtractProject()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, project == nil)
+
+	catalog, err := result.ExtractServiceCatalog()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, 0, len(catalog.Entries))
+
+	system, err = getGetResult(t).ExtractSystem()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, true, system == nil)
+}
+
+func TestExtractApplicationCredential(t *testing.T) {
+	result := getGetSystemResult(t)
+
+	appCred, err := result.ExtractApplicationCredential()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, &ExpectedApplicationCredential, appCred)
+
+	methods, err := result.ExtractMethods()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"application_credential"}, methods)
+
+	auditIDs, err := result.ExtractAuditIDs()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []string{"VcxU2JYqT8OzfUVvrjEITQ", "qNUTIJntTzO1-XUk5STybw"}, auditIDs)
+}
@@ -0,0 +1,325 @@
+package testing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+const validatedTokenOutput = `
+{
+    "token": {
+        "audit_ids": ["VcxU2JYqT8OzfUVvrjEITQ"],
+        "expires_at": "2099-06-03T02:19:49.000000Z",
+        "issued_at": "2017-06-03T01:19:49.000000Z",
+        "methods": ["password"],
+        "project": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "263fd9",
+            "name": "demo"
+        },
+        "roles": [
+            {
+                "id": "434426788d5a451faf763b0e6db5aefb",
+                "name": "member"
+            }
+        ],
+        "user": {
+            "domain": {
+                "id": "default",
+                "name": "Default"
+            },
+            "id": "0fe63b5307ba4c20ae5c4c9e0f21dd42",
+            "name": "demo"
+        }
+    }
+}
+`
+
+// tokenLifetime is the lifetime of the token of validatedTokenOutput.
+const tokenLifetime = 82 * 365 * 24 * time.Hour
+
+func newValidatorClient() *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{TokenID: "12345abcdef"},
+		Endpoint:       th.Endpoint(),
+	}
+}
+
+// handleValidator creates HTTP handlers for token validations and revocation
+// events. It returns the number of validations requested, and a pointer to
+// the events served.
+func handleValidator(t *testing.T) (*int, *string) {
+	validations := 0
+	events := `[]`
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", "12345abcdef")
+		th.TestFormValues(t, r, map[string]string{"nocatalog": "true"})
+		validations++
+
+		if r.Header.Get("X-Subject-Token") != "abcdef12345" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "abcdef12345")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, validatedTokenOutput)
+	})
+
+	th.Mux.HandleFunc("/auth/tokens/OS-REVOKE/events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", "12345abcdef")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"events": %s}`, events)
+	})
+
+	return &validations, &events
+}
+
+func TestValidatorOnline(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	validations, events := handleValidator(t)
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		RevocationInterval: time.Nanosecond,
+		MaxTokenLifetime:   tokenLifetime,
+	})
+
+	token, err := v.Validate("abcdef12345")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "abcdef12345", token.ID)
+	th.AssertEquals(t, false, token.Offline)
+	th.AssertEquals(t, "263fd9", token.Project.ID)
+	th.AssertEquals(t, "demo", token.User.Name)
+	th.CheckDeepEquals(t, []tokens.Role{{ID: "434426788d5a451faf763b0e6db5aefb", Name: "member"}}, token.Roles)
+
+	// The second validation is served from the cache, which is not affected
+	// by changes to the tokens returned.
+	token.Roles[0].Name = "admin"
+	token.Project.ID = "other"
+	token, err = v.Validate("abcdef12345")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, *validations)
+	th.AssertEquals(t, "member", token.Roles[0].Name)
+	th.AssertEquals(t, "263fd9", token.Project.ID)
+
+	// A revocation event revokes the cached token.
+	*events = `[{"audit_id": "VcxU2JYqT8OzfUVvrjEITQ", "issued_before": "2017-06-03T01:20:00.000000Z"}]`
+	_, err = v.Validate("abcdef12345")
+	_, ok := err.(tokens.ErrTokenRevoked)
+	th.AssertEquals(t, true, ok)
+
+	_, err = v.Validate("unknown")
+	_, ok = err.(tokens.ErrInvalidToken)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestValidatorRevocationEventsSince(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "abcdef12345")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, validatedTokenOutput)
+	})
+
+	var since []string
+	th.Mux.HandleFunc("/auth/tokens/OS-REVOKE/events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		since = append(since, r.URL.Query().Get("since"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch len(since) {
+		case 1:
+			fmt.Fprint(w, `{"events": [{"user_id": "other", "issued_before": "2017-06-03T01:20:00.000000Z", "revoked_at": "2017-06-03T01:20:00.123456Z"}]}`)
+		default:
+			// The event already known is returned again, along with a new
+			// one revoking the token.
+			fmt.Fprint(w, `{"events": [
+				{"user_id": "other", "issued_before": "2017-06-03T01:20:00.000000Z", "revoked_at": "2017-06-03T01:20:00.123456Z"},
+				{"audit_id": "VcxU2JYqT8OzfUVvrjEITQ", "issued_before": "2017-06-03T01:20:00.000000Z", "revoked_at": "2017-06-03T01:25:00.000000Z"}
+			]}`)
+		}
+	})
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		RevocationInterval: time.Nanosecond,
+		MaxTokenLifetime:   tokenLifetime,
+	})
+
+	_, err := v.Validate("abcdef12345")
+	th.AssertNoErr(t, err)
+
+	_, err = v.Validate("abcdef12345")
+	_, ok := err.(tokens.ErrTokenRevoked)
+	th.AssertEquals(t, true, ok)
+
+	// Only the events recorded after the last one known are requested.
+	th.CheckDeepEquals(t, []string{"", "2017-06-03T01:20:00.123456Z"}, since[:2])
+}
+
+func TestValidatorRevocationEventsPruned(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	_, events := handleValidator(t)
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		RevocationInterval: time.Nanosecond,
+	})
+
+	// The event only revokes tokens issued more than MaxTokenLifetime ago,
+	// which must have expired already, so it is dropped. The token of the
+	// fixture outlives MaxTokenLifetime, which is why it is not revoked.
+	*events = `[{"audit_id": "VcxU2JYqT8OzfUVvrjEITQ", "issued_before": "2017-06-03T01:20:00.000000Z", "revoked_at": "2017-06-03T01:20:00.000000Z"}]`
+	_, err := v.Validate("abcdef12345")
+	th.AssertNoErr(t, err)
+}
+
+func TestValidatorErrors(t *testing.T) {
+	th.AssertEquals(t, "Invalid token: unknown token", tokens.ErrInvalidToken{Reason: "unknown token"}.Error())
+	th.AssertEquals(t, "Token has been revoked", tokens.ErrTokenRevoked{}.Error())
+
+	err := tokens.ErrTokenRevoked{}
+	err.Info = "custom message"
+	th.AssertEquals(t, "custom message", err.Error())
+}
+
+func TestValidatorConcurrent(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleValidator(t)
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		RevocationInterval: time.Nanosecond,
+	})
+	_, err := v.Validate("abcdef12345")
+	th.AssertNoErr(t, err)
+
+	// The token is served from the cache while the revocation events are
+	// fetched again.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := v.Validate("abcdef12345")
+			th.AssertNoErr(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestValidatorNoCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	validations, _ := handleValidator(t)
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		CacheTTL:           -1,
+		RevocationInterval: -1,
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := v.Validate("abcdef12345")
+		th.AssertNoErr(t, err)
+	}
+	th.AssertEquals(t, 2, *validations)
+}
+
+func signJWS(t *testing.T, key *ecdsa.PrivateKey, payload map[string]interface{}) string {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"ES256","typ":"JWT"}`))
+	b, err := json.Marshal(payload)
+	th.AssertNoErr(t, err)
+	signingInput := header + "." + enc.EncodeToString(b)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	th.AssertNoErr(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return signingInput + "." + enc.EncodeToString(sig)
+}
+
+func TestValidatorJWS(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	validations, _ := handleValidator(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	th.AssertNoErr(t, err)
+	keys, err := tokens.ParseJWSPublicKeys(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	th.AssertNoErr(t, err)
+
+	v := tokens.NewValidator(newValidatorClient(), tokens.ValidatorOpts{
+		JWSPublicKeys: keys,
+	})
+
+	now := time.Now().Unix()
+	tokenID := signJWS(t, key, map[string]interface{}{
+		"sub":                  "0fe63b5307ba4c20ae5c4c9e0f21dd42",
+		"iat":                  now,
+		"exp":                  now + 3600,
+		"openstack_methods":    []string{"password"},
+		"openstack_audit_ids":  []string{"VcxU2JYqT8OzfUVvrjEITQ"},
+		"openstack_project_id": "263fd9",
+	})
+
+	token, err := v.Validate(tokenID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, token.Offline)
+	th.AssertEquals(t, "0fe63b5307ba4c20ae5c4c9e0f21dd42", token.User.ID)
+	th.AssertEquals(t, "263fd9", token.Project.ID)
+	th.AssertEquals(t, now+3600, token.ExpiresAt.Unix())
+	th.AssertEquals(t, 0, *validations)
+
+	// A token signed with another key is rejected.
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+	_, err = v.Validate(signJWS(t, otherKey, map[string]interface{}{
+		"sub": "0fe63b5307ba4c20ae5c4c9e0f21dd42",
+		"iat": now,
+		"exp": now + 3600,
+	}))
+	_, ok := err.(tokens.ErrInvalidToken)
+	th.AssertEquals(t, true, ok)
+
+	_, err = v.Validate(signJWS(t, key, map[string]interface{}{
+		"sub": "0fe63b5307ba4c20ae5c4c9e0f21dd42",
+		"iat": now - 7200,
+		"exp": now - 3600,
+	}))
+	_, ok = err.(tokens.ErrTokenExpired)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, 0, *validations)
+}
@@ -5,3 +5,7 @@ import "github.com/yogeshwargnanasekaran/gophercloud"
 func tokenURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("auth", "tokens")
 }
+
+func availableSystemsURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("auth", "system")
+}
@@ -0,0 +1,477 @@
+package tokens
+
+import (
+	"crypto/ecdsa"
+	"sync"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/extensions/revoke"
+)
+
+const (
+	// defaultValidatorCacheTTL is the default ValidatorOpts.CacheTTL.
+	defaultValidatorCacheTTL = 5 * time.Minute
+
+	// defaultRevocationInterval is the default
+	// ValidatorOpts.RevocationInterval.
+	defaultRevocationInterval = 10 * time.Second
+
+	// defaultMaxTokenLifetime is the default ValidatorOpts.MaxTokenLifetime.
+	defaultMaxTokenLifetime = 24 * time.Hour
+)
+
+// ValidatedToken is the view of a token a Validator returns: the details of
+// the token Get returns, without the service catalog.
+type ValidatedToken struct {
+	Token
+
+	// IssuedAt is the time at which the token was issued.
+	IssuedAt time.Time
+
+	// User is the owner of the token.
+	User User
+
+	// Roles are the roles granted by the token.
+	Roles []Role
+
+	// Project is the project the token is scoped to, if any.
+	Project *Project
+
+	// Domain is the domain the token is scoped to, if any.
+	Domain *Domain
+
+	// System is the system the token is scoped to, if any.
+	System *System
+
+	// IsDomain is true if Project is a domain acting as a project.
+	IsDomain bool
+
+	// Methods are the authentication methods the token was issued with.
+	Methods []string
+
+	// AuditIDs are the audit IDs of the token.
+	AuditIDs []string
+
+	// TrustID is the ID of the trust the token was issued for, if any.
+	TrustID string
+
+	// ApplicationCredential is the application credential the token was
+	// issued for, if any.
+	ApplicationCredential *ApplicationCredential
+
+	// Offline is true if the token was validated offline from its JWS
+	// signature. JWS tokens only carry IDs: the names of User, Project and
+	// Domain are empty, and Roles is nil.
+	Offline bool
+
+	userDomainID  string
+	scopeDomainID string
+	trustorID     string
+	trusteeID     string
+	consumerID    string
+	accessTokenID string
+}
+
+// clone returns a deep copy of t, so that the tokens returned by a Validator
+// do not share memory with its cache.
+func (t *ValidatedToken) clone() *ValidatedToken {
+	c := *t
+	c.Roles = append([]Role(nil), t.Roles...)
+	c.Methods = append([]string(nil), t.Methods...)
+	c.AuditIDs = append([]string(nil), t.AuditIDs...)
+	if t.Project != nil {
+		project := *t.Project
+		c.Project = &project
+	}
+	if t.Domain != nil {
+		domain := *t.Domain
+		c.Domain = &domain
+	}
+	if t.System != nil {
+		system := *t.System
+		c.System = &system
+	}
+	if t.ApplicationCredential != nil {
+		appCred := *t.ApplicationCredential
+		appCred.AccessRules = append([]AccessRule(nil), t.ApplicationCredential.AccessRules...)
+		c.ApplicationCredential = &appCred
+	}
+	return &c
+}
+
+// revocationToken returns the attributes revocation events are matched
+// against.
+func (t *ValidatedToken) revocationToken() revoke.Token {
+	rt := revoke.Token{
+		UserID:        t.User.ID,
+		UserDomainID:  t.userDomainID,
+		ScopeDomainID: t.scopeDomainID,
+		TrustID:       t.TrustID,
+		TrustorID:     t.trustorID,
+		TrusteeID:     t.trusteeID,
+		ConsumerID:    t.consumerID,
+		AccessTokenID: t.accessTokenID,
+		AuditIDs:      t.AuditIDs,
+		IssuedAt:      t.IssuedAt,
+		ExpiresAt:     t.ExpiresAt,
+	}
+	if t.Project != nil {
+		rt.ProjectID = t.Project.ID
+	}
+	for _, role := range t.Roles {
+		rt.RoleIDs = append(rt.RoleIDs, role.ID)
+	}
+	return rt
+}
+
+// ValidatorOpts configures a Validator.
+type ValidatorOpts struct {
+	// CacheTTL is the time a validated token is cached for. Tokens are never
+	// cached past their expiry. It defaults to 5 minutes; a negative value
+	// disables the cache.
+	CacheTTL time.Duration
+
+	// RevocationInterval is the interval at which the revocation events are
+	// fetched, see the revoke package. It defaults to 10 seconds; a negative
+	// value disables the revocation checks.
+	RevocationInterval time.Duration
+
+	// MaxTokenLifetime is the longest lifetime of the tokens Keystone issues.
+	// The revocation events older than that only match expired tokens, and
+	// are dropped. It defaults to 24 hours, and must not be shorter than the
+	// token expiration Keystone is configured with.
+	MaxTokenLifetime time.Duration
+
+	// JWSPublicKeys are the keys Keystone signs JWS tokens with, see
+	// ParseJWSPublicKeys. If set, JWS tokens are validated offline, without
+	// requesting Keystone.
+	JWSPublicKeys []*ecdsa.PublicKey
+}
+
+// Validator validates the tokens of other users, typically the
+// X-Auth-Token of the requests to a service, at high rates. It caches the
+// results of Get, and honors the revocation events of Keystone.
+//
+// Offline validation of JWS tokens cannot match the revocation events
+// revoking a role, or the tokens of the users of a domain, since JWS tokens
+// only carry the IDs of their user and scope.
+//
+// A Validator is safe for concurrent use.
+type Validator struct {
+	client *gophercloud.ServiceClient
+	opts   ValidatorOpts
+
+	mut   sync.Mutex
+	cache map[string]validatorEntry
+	sweep int
+
+	eventsMut       sync.Mutex
+	events          []revoke.Event
+	eventsFetchedAt time.Time
+	eventsSince     time.Time
+	eventsFetching  chan struct{}
+}
+
+type validatorEntry struct {
+	token     *ValidatedToken
+	expiresAt time.Time
+}
+
+// NewValidator returns a Validator requesting Keystone with client, which
+// must be authorized to validate the tokens of other users.
+func NewValidator(client *gophercloud.ServiceClient, opts ValidatorOpts) *Validator {
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = defaultValidatorCacheTTL
+	}
+	if opts.RevocationInterval == 0 {
+		opts.RevocationInterval = defaultRevocationInterval
+	}
+	if opts.MaxTokenLifetime <= 0 {
+		opts.MaxTokenLifetime = defaultMaxTokenLifetime
+	}
+	return &Validator{
+		client: client,
+		opts:   opts,
+		cache:  make(map[string]validatorEntry),
+	}
+}
+
+// Validate validates a token, and returns its details. It returns
+// ErrInvalidToken, ErrTokenExpired or ErrTokenRevoked if the token is not
+// valid.
+func (v *Validator) Validate(tokenID string) (*ValidatedToken, error) {
+	events, err := v.revocationEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if t, ok := v.cached(tokenID, now); ok {
+		if revoke.IsRevoked(events, t.revocationToken()) {
+			v.Invalidate(tokenID)
+			return nil, ErrTokenRevoked{}
+		}
+		return t, nil
+	}
+
+	var t *ValidatedToken
+	if len(v.opts.JWSPublicKeys) > 0 && isJWS(tokenID) {
+		payload, err := verifyJWS(tokenID, v.opts.JWSPublicKeys)
+		if err != nil {
+			return nil, err
+		}
+		t = payload.validatedToken(tokenID)
+	} else {
+		t, err = v.validateOnline(tokenID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !now.Before(t.ExpiresAt) {
+		return nil, ErrTokenExpired{ExpiresAt: t.ExpiresAt}
+	}
+	if revoke.IsRevoked(events, t.revocationToken()) {
+		return nil, ErrTokenRevoked{}
+	}
+
+	v.store(tokenID, t, now)
+	return t.clone(), nil
+}
+
+// Invalidate removes a token from the cache.
+func (v *Validator) Invalidate(tokenID string) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	delete(v.cache, tokenID)
+}
+
+func (v *Validator) cached(tokenID string, now time.Time) (*ValidatedToken, bool) {
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	e, ok := v.cache[tokenID]
+	if !ok {
+		return nil, false
+	}
+	if !now.Before(e.expiresAt) {
+		delete(v.cache, tokenID)
+		return nil, false
+	}
+	return e.token.clone(), true
+}
+
+func (v *Validator) store(tokenID string, t *ValidatedToken, now time.Time) {
+	if v.opts.CacheTTL < 0 {
+		return
+	}
+	expiresAt := now.Add(v.opts.CacheTTL)
+	if t.ExpiresAt.Before(expiresAt) {
+		expiresAt = t.ExpiresAt
+	}
+
+	v.mut.Lock()
+	defer v.mut.Unlock()
+	v.cache[tokenID] = validatorEntry{token: t, expiresAt: expiresAt}
+
+	// Drop the expired entries whenever the cache doubles in size, so that
+	// tokens which are not validated again do not accumulate.
+	if len(v.cache) > 2*v.sweep {
+		for id, e := range v.cache {
+			if !now.Before(e.expiresAt) {
+				delete(v.cache, id)
+			}
+		}
+		v.sweep = len(v.cache)
+	}
+}
+
+// revocationEvents returns the revocation events, fetching them again if
+// they are older than the RevocationInterval. Only one goroutine fetches the
+// events at a time; the others are served the previous events meanwhile.
+func (v *Validator) revocationEvents() ([]revoke.Event, error) {
+	if v.opts.RevocationInterval < 0 {
+		return nil, nil
+	}
+
+	v.eventsMut.Lock()
+	fetched := !v.eventsFetchedAt.IsZero()
+	if fetched && (v.eventsFetching != nil || time.Since(v.eventsFetchedAt) < v.opts.RevocationInterval) {
+		events := v.events
+		v.eventsMut.Unlock()
+		return events, nil
+	}
+	if done := v.eventsFetching; done != nil {
+		// The events have never been fetched, so there are none to serve:
+		// wait for the fetch in progress.
+		v.eventsMut.Unlock()
+		<-done
+		return v.revocationEvents()
+	}
+	done := make(chan struct{})
+	v.eventsFetching = done
+	since := v.eventsSince
+	v.eventsMut.Unlock()
+
+	events, err := fetchRevocationEvents(v.client, since)
+
+	v.eventsMut.Lock()
+	defer v.eventsMut.Unlock()
+	v.eventsFetching = nil
+	close(done)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range events {
+		if e.RevokedAt.After(v.eventsSince) {
+			v.eventsSince = e.RevokedAt
+		}
+	}
+	if !since.IsZero() {
+		events = mergeRevocationEvents(v.events, events, since)
+	}
+	now := time.Now()
+	v.events = pruneRevocationEvents(events, now.Add(-v.opts.MaxTokenLifetime), now)
+	v.eventsFetchedAt = now
+	return v.events, nil
+}
+
+// fetchRevocationEvents lists the revocation events recorded after since, or
+// all of them if since is zero.
+func fetchRevocationEvents(client *gophercloud.ServiceClient, since time.Time) ([]revoke.Event, error) {
+	var opts revoke.ListEventsOptsBuilder
+	if !since.IsZero() {
+		opts = revoke.ListEventsOpts{Since: since}
+	}
+	allPages, err := revoke.ListEvents(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return revoke.ExtractEvents(allPages)
+}
+
+// mergeRevocationEvents appends the events fetched since a given time to the
+// known events. The events recorded at that very time may be returned again;
+// they are skipped.
+func mergeRevocationEvents(known, fetched []revoke.Event, since time.Time) []revoke.Event {
+	var recent []revoke.Event
+	for _, e := range known {
+		if !e.RevokedAt.Before(since) {
+			recent = append(recent, e)
+		}
+	}
+
+	merged := known
+next:
+	for _, e := range fetched {
+		for _, r := range recent {
+			if sameRevocationEvent(e, r) {
+				continue next
+			}
+		}
+		merged = append(merged, e)
+	}
+	return merged
+}
+
+// pruneRevocationEvents returns the events that may still match an unexpired
+// token: the events revoking the tokens issued before issuedAfter, or expiring
+// before now, are dropped. events is not modified, since it is shared with the
+// callers of revocationEvents.
+func pruneRevocationEvents(events []revoke.Event, issuedAfter, now time.Time) []revoke.Event {
+	var kept []revoke.Event
+	for _, e := range events {
+		if !e.IssuedBefore.IsZero() && e.IssuedBefore.Before(issuedAfter) {
+			continue
+		}
+		if !e.ExpiresAt.IsZero() && e.ExpiresAt.Before(now) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	return kept
+}
+
+func sameRevocationEvent(a, b revoke.Event) bool {
+	if !a.IssuedBefore.Equal(b.IssuedBefore) || !a.RevokedAt.Equal(b.RevokedAt) || !a.ExpiresAt.Equal(b.ExpiresAt) {
+		return false
+	}
+	a.IssuedBefore, a.RevokedAt, a.ExpiresAt = time.Time{}, time.Time{}, time.Time{}
+	b.IssuedBefore, b.RevokedAt, b.ExpiresAt = time.Time{}, time.Time{}, time.Time{}
+	return a == b
+}
+
+// validateOnline validates a token with Get.
+func (v *Validator) validateOnline(tokenID string) (*ValidatedToken, error) {
+	r := GetWithOpts(v.client, tokenID, GetOpts{NoCatalog: true})
+	if r.Err != nil {
+		if _, ok := r.Err.(gophercloud.ErrDefault404); ok {
+			return nil, ErrInvalidToken{Reason: "unknown token"}
+		}
+		return nil, r.Err
+	}
+
+	var s struct {
+		ExpiresAt             time.Time              `json:"expires_at"`
+		IssuedAt              time.Time              `json:"issued_at"`
+		User                  User                   `json:"user"`
+		Roles                 []Role                 `json:"roles"`
+		Project               *Project               `json:"project"`
+		Domain                *Domain                `json:"domain"`
+		System                *System                `json:"system"`
+		IsDomain              bool                   `json:"is_domain"`
+		Methods               []string               `json:"methods"`
+		AuditIDs              []string               `json:"audit_ids"`
+		ApplicationCredential *ApplicationCredential `json:"application_credential"`
+		Trust                 *struct {
+			ID          string `json:"id"`
+			TrustorUser struct {
+				ID string `json:"id"`
+			} `json:"trustor_user"`
+			TrusteeUser struct {
+				ID string `json:"id"`
+			} `json:"trustee_user"`
+		} `json:"OS-TRUST:trust"`
+		OAuth1 *struct {
+			AccessTokenID string `json:"access_token_id"`
+			ConsumerID    string `json:"consumer_id"`
+		} `json:"OS-OAUTH1"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return nil, err
+	}
+
+	t := &ValidatedToken{
+		Token: Token{
+			ID:        tokenID,
+			ExpiresAt: s.ExpiresAt,
+		},
+		IssuedAt:              s.IssuedAt,
+		User:                  s.User,
+		Roles:                 s.Roles,
+		Project:               s.Project,
+		Domain:                s.Domain,
+		System:                s.System,
+		IsDomain:              s.IsDomain,
+		Methods:               s.Methods,
+		AuditIDs:              s.AuditIDs,
+		ApplicationCredential: s.ApplicationCredential,
+		userDomainID:          s.User.Domain.ID,
+	}
+	if s.Project != nil {
+		t.scopeDomainID = s.Project.Domain.ID
+	} else if s.Domain != nil {
+		t.scopeDomainID = s.Domain.ID
+	}
+	if s.Trust != nil {
+		t.TrustID = s.Trust.ID
+		t.trustorID = s.Trust.TrustorUser.ID
+		t.trusteeID = s.Trust.TrusteeUser.ID
+	}
+	if s.OAuth1 != nil {
+		t.accessTokenID = s.OAuth1.AccessTokenID
+		t.consumerID = s.OAuth1.ConsumerID
+	}
+	return t, nil
+}
@@ -138,6 +138,12 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	})
 }
 
+// ListIterator returns an Iterator over the images returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(c *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Image] {
+	return pagination.NewIterator(List(c, opts), ExtractImages)
+}
+
 // CreateOptsBuilder allows extensions to add parameters to the Create request.
 type CreateOptsBuilder interface {
 	// Returns value that can be passed to json.Marshal
@@ -0,0 +1,38 @@
+package images
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the image
+// to reach one of the target statuses. It fails as soon as the image is
+// killed.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...ImageStatus) *gophercloud.StateWaiter[*Image] {
+	states := make([]string, len(target))
+	for i, status := range target {
+		states[i] = string(status)
+	}
+
+	return &gophercloud.StateWaiter[*Image]{
+		Get: func(ctx context.Context) (*Image, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(i *Image) string {
+			return string(i.Status)
+		},
+		Target:  states,
+		Failure: []string{string(ImageStatusKilled)},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the image
+// to be deleted. Unlike NewStatusWaiter, it does not fail on killed, since
+// killed images can be deleted.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Image] {
+	w := NewStatusWaiter(c, id, ImageStatusDeleted)
+	w.Failure = nil
+	w.NotFoundIsTarget = true
+	return w
+}
@@ -0,0 +1,33 @@
+package loadbalancers
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the
+// provisioning status of the load balancer to reach one of the target
+// statuses. It fails as soon as the load balancer goes to ERROR.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*LoadBalancer] {
+	return &gophercloud.StateWaiter[*LoadBalancer]{
+		Get: func(ctx context.Context) (*LoadBalancer, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(lb *LoadBalancer) string {
+			return lb.ProvisioningStatus
+		},
+		Target:  target,
+		Failure: []string{"ERROR"},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the load
+// balancer to be deleted. Unlike NewStatusWaiter, it does not fail on ERROR,
+// since load balancers in ERROR can be deleted.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*LoadBalancer] {
+	w := NewStatusWaiter(c, id, "DELETED")
+	w.Failure = nil
+	w.NotFoundIsTarget = true
+	return w
+}
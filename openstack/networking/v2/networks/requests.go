@@ -58,6 +58,12 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	})
 }
 
+// ListIterator returns an Iterator over the networks returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(c *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Network] {
+	return pagination.NewIterator(List(c, opts), ExtractNetworks)
+}
+
 // Get retrieves a specific network based on its unique ID.
 func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
 	resp, err := c.Get(getURL(c, id), &r.Body, nil)
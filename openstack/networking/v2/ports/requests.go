@@ -95,6 +95,12 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	})
 }
 
+// ListIterator returns an Iterator over the ports returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(c *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Port] {
+	return pagination.NewIterator(List(c, opts), ExtractPorts)
+}
+
 // Get retrieves a specific port based on its unique ID.
 func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
 	resp, err := c.Get(getURL(c, id), &r.Body, nil)
@@ -69,6 +69,28 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListIterator(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	var ids []string
+	it := ports.ListIterator(fake.ServiceClient(), ports.ListOpts{})
+	for it.Next() {
+		ids = append(ids, it.Value().ID)
+	}
+	th.AssertNoErr(t, it.Err())
+	th.CheckDeepEquals(t, []string{"d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b"}, ids)
+}
 func TestListWithExtensions(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -67,6 +67,12 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	})
 }
 
+// ListIterator returns an Iterator over the subnets returned by List. Pages are
+// fetched lazily, as the Iterator advances.
+func ListIterator(c *gophercloud.ServiceClient, opts ListOptsBuilder) *pagination.Iterator[Subnet] {
+	return pagination.NewIterator(List(c, opts), ExtractSubnets)
+}
+
 // Get retrieves a specific subnet based on its unique ID.
 func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
 	resp, err := c.Get(getURL(c, id), &r.Body, nil)
@@ -76,6 +76,19 @@ func List(c *gophercloud.ServiceClient, containerName string, opts ListOptsBuild
 	return pager
 }
 
+// ListIterator returns an Iterator over the objects of a container, with their
+// full information. The listing must be requested in JSON format, by setting
+// ListOpts.Full to true; use ListNamesIterator otherwise.
+func ListIterator(c *gophercloud.ServiceClient, containerName string, opts ListOptsBuilder) *pagination.Iterator[Object] {
+	return pagination.NewIterator(List(c, containerName, opts), ExtractInfo)
+}
+
+// ListNamesIterator returns an Iterator over the names of the objects of a
+// container, whatever the format of the listing.
+func ListNamesIterator(c *gophercloud.ServiceClient, containerName string, opts ListOptsBuilder) *pagination.Iterator[string] {
+	return pagination.NewIterator(List(c, containerName, opts), ExtractNames)
+}
+
 // DownloadOptsBuilder allows extensions to add additional parameters to the
 // Download request.
 type DownloadOptsBuilder interface {
@@ -138,6 +138,34 @@ func TestListObjectNames(t *testing.T) {
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListIterator(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListObjectsInfoSuccessfully(t)
+
+	var actual []objects.Object
+	it := objects.ListIterator(fake.ServiceClient(), "testContainer", &objects.ListOpts{Full: true})
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	th.AssertNoErr(t, it.Err())
+	th.CheckDeepEquals(t, ExpectedListInfo, actual)
+}
+
+func TestListNamesIterator(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListObjectNamesSuccessfully(t)
+
+	var actual []string
+	it := objects.ListNamesIterator(fake.ServiceClient(), "testContainer", &objects.ListOpts{Full: false})
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	th.AssertNoErr(t, it.Err())
+	th.CheckDeepEquals(t, ExpectedListNames, actual)
+}
+
 func TestCreateObject(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
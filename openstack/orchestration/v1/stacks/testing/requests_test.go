@@ -1,6 +1,8 @@
 package testing
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/yogeshwargnanasekaran/gophercloud"
@@ -257,3 +259,17 @@ func TestAbandonStack(t *testing.T) {
 	expected := AbandonExpected
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestStatusWaiter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	output := strings.Replace(GetOutput, `"stack_status": "CREATE_COMPLETE"`, `"stack_status": "CREATE_FAILED"`, 1)
+	output = strings.Replace(output, "Stack CREATE completed successfully", "Resource CREATE failed: Quota exceeded", 1)
+	HandleGetSuccessfully(t, output)
+
+	_, err := stacks.NewStatusWaiter(fake.ServiceClient(), "postman_stack", "16ef0584-4458-41eb-87c8-0dc8d5f66c87", "CREATE_COMPLETE").Wait(context.Background())
+	failure, ok := err.(gophercloud.ErrStateFailure[*stacks.RetrievedStack])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "CREATE_FAILED", failure.State)
+	th.AssertEquals(t, "Resource CREATE failed: Quota exceeded", failure.Detail)
+}
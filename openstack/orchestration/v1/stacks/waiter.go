@@ -0,0 +1,43 @@
+package stacks
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// failedStatuses are the statuses of the stacks whose last action failed.
+var failedStatuses = []string{
+	"INIT_FAILED", "CREATE_FAILED", "DELETE_FAILED", "UPDATE_FAILED", "ROLLBACK_FAILED",
+	"SUSPEND_FAILED", "RESUME_FAILED", "ADOPT_FAILED", "SNAPSHOT_FAILED", "CHECK_FAILED",
+	"RESTORE_FAILED",
+}
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the stack
+// to reach one of the target statuses. It fails as soon as an action of the
+// stack fails, with the reason of the status of the stack.
+func NewStatusWaiter(c *gophercloud.ServiceClient, stackName, stackID string, target ...string) *gophercloud.StateWaiter[*RetrievedStack] {
+	return &gophercloud.StateWaiter[*RetrievedStack]{
+		Get: func(ctx context.Context) (*RetrievedStack, error) {
+			return Get(c.WithContext(ctx), stackName, stackID).Extract()
+		},
+		State: func(s *RetrievedStack) string {
+			return s.Status
+		},
+		Detail: func(s *RetrievedStack) string {
+			return s.StatusReason
+		},
+		Target:  target,
+		Failure: failedStatuses,
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the stack
+// to be deleted. It fails on DELETE_FAILED only, so that failed stacks can be
+// cleaned up.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, stackName, stackID string) *gophercloud.StateWaiter[*RetrievedStack] {
+	w := NewStatusWaiter(c, stackName, stackID, "DELETE_COMPLETE")
+	w.Failure = []string{"DELETE_FAILED"}
+	w.NotFoundIsTarget = true
+	return w
+}
@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"os"
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// serviceType is an entry of the OpenStack service-types authority
+// (https://service-types.openstack.org/service-types.json).
+type serviceType struct {
+	// Type is the official service type.
+	Type string
+
+	// Aliases are the other names under which the current version of the
+	// service may be registered in a service catalog.
+	Aliases []string
+
+	// Legacy are aliases of the service that designate older API versions.
+	// They only match themselves, so that a client of an older API version is
+	// never handed the endpoint of a newer one, or vice versa.
+	Legacy []string
+}
+
+var serviceTypes = []serviceType{
+	{Type: "block-storage", Aliases: []string{"volumev3", "block-store"}, Legacy: []string{"volumev2", "volume"}},
+	{Type: "shared-file-system", Aliases: []string{"sharev2"}, Legacy: []string{"share"}},
+	{Type: "container-infrastructure-management", Aliases: []string{"container-infrastructure", "container-infra"}},
+	{Type: "baremetal", Aliases: []string{"bare-metal"}},
+	{Type: "clustering", Aliases: []string{"cluster"}},
+	{Type: "workflow", Aliases: []string{"workflowv2"}},
+	{Type: "application-container", Aliases: []string{"container"}},
+}
+
+/*
+ServiceTypeAliases returns the service types under which a service of type t
+may be registered in a service catalog: t itself first, then the official
+service type and the other aliases published by the OpenStack service-types
+authority. For example, "volumev3" resolves to "volumev3", "block-storage" and
+"block-store".
+
+Aliases designating older API versions, such as "volumev2", only resolve to
+themselves. Unknown service types also resolve to themselves only.
+*/
+func ServiceTypeAliases(t string) []string {
+	for _, st := range serviceTypes {
+		for _, legacy := range st.Legacy {
+			if t == legacy {
+				return []string{t}
+			}
+		}
+
+		if t != st.Type && !contains(st.Aliases, t) {
+			continue
+		}
+
+		types := []string{t}
+		for _, candidate := range append([]string{st.Type}, st.Aliases...) {
+			if candidate != t {
+				types = append(types, candidate)
+			}
+		}
+		return types
+	}
+	return []string{t}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointOverride returns the endpoint set in opts.EndpointOverrides for the
+// service type opts.Type or any of its aliases.
+func endpointOverride(opts gophercloud.EndpointOpts) (string, bool) {
+	if len(opts.EndpointOverrides) == 0 {
+		return "", false
+	}
+	for _, t := range ServiceTypeAliases(opts.Type) {
+		if url, ok := opts.EndpointOverrides[t]; ok && url != "" {
+			return gophercloud.NormalizeURL(url), true
+		}
+	}
+	return "", false
+}
+
+// locateEndpoint returns the endpoint overridden for the service described by
+// opts, if any, and otherwise looks it up with the EndpointLocator of client.
+func locateEndpoint(client *gophercloud.ProviderClient, opts gophercloud.EndpointOpts) (string, error) {
+	if url, ok := endpointOverride(opts); ok {
+		return url, nil
+	}
+	if client.EndpointLocator == nil {
+		return "", &gophercloud.ErrEndpointNotFound{Types: ServiceTypeAliases(opts.Type)}
+	}
+	return client.EndpointLocator(opts)
+}
+
+/*
+EndpointOverridesFromEnv returns the endpoint overrides set with the
+OS_<SERVICE_TYPE>_ENDPOINT_OVERRIDE environment variables, keyed by service
+type. Service types are derived from the variable names by lower-casing them
+and replacing underscores with dashes, so OS_BLOCK_STORAGE_ENDPOINT_OVERRIDE
+overrides the endpoint of the "block-storage" service and of its aliases:
+
+	eo := gophercloud.EndpointOpts{
+		Region:            "RegionOne",
+		EndpointOverrides: openstack.EndpointOverridesFromEnv(),
+	}
+	client, err := openstack.NewBlockStorageV3(provider, eo)
+*/
+func EndpointOverridesFromEnv() map[string]string {
+	const prefix, suffix = "OS_", "_ENDPOINT_OVERRIDE"
+
+	overrides := make(map[string]string)
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			continue
+		}
+		name := parts[0]
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) || len(name) <= len(prefix)+len(suffix) {
+			continue
+		}
+		t := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(name, prefix), suffix))
+		overrides[strings.Replace(t, "_", "-", -1)] = parts[1]
+	}
+	return overrides
+}
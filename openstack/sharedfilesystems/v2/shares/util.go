@@ -0,0 +1,36 @@
+package shares
+
+import (
+	"context"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// NewStatusWaiter returns a gophercloud.StateWaiter that waits for the share
+// to reach one of the target statuses. It fails as soon as the share goes to
+// one of the error statuses.
+func NewStatusWaiter(c *gophercloud.ServiceClient, id string, target ...string) *gophercloud.StateWaiter[*Share] {
+	return &gophercloud.StateWaiter[*Share]{
+		Get: func(ctx context.Context) (*Share, error) {
+			return Get(c.WithContext(ctx), id).Extract()
+		},
+		State: func(s *Share) string {
+			return s.Status
+		},
+		Target: target,
+		Failure: []string{
+			"error", "error_deleting", "extending_error", "shrinking_error",
+			"shrinking_possible_data_loss_error", "manage_error", "unmanage_error",
+			"reverting_error",
+		},
+	}
+}
+
+// NewDeleteWaiter returns a gophercloud.StateWaiter that waits for the share
+// to be deleted. It fails on error_deleting only.
+func NewDeleteWaiter(c *gophercloud.ServiceClient, id string) *gophercloud.StateWaiter[*Share] {
+	w := NewStatusWaiter(c, id, "deleted")
+	w.Failure = []string{"error_deleting"}
+	w.NotFoundIsTarget = true
+	return w
+}
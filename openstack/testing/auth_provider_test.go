@@ -0,0 +1,155 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+// staticResult is the AuthResult of staticAuthProvider.
+type staticResult struct {
+	id        string
+	expiresAt time.Time
+}
+
+func (r staticResult) ExtractTokenID() (string, error) {
+	return r.id, nil
+}
+
+func (r staticResult) ExtractExpiresAt() (time.Time, error) {
+	return r.expiresAt, nil
+}
+
+// staticAuthProvider issues the tokens "token-1", "token-2"... as a token
+// broker would.
+type staticAuthProvider struct {
+	mut       sync.Mutex
+	issued    int
+	throwaway bool
+	revoked   []string
+}
+
+func (p *staticAuthProvider) issue() staticResult {
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	p.issued++
+	return staticResult{id: fmt.Sprintf("token-%d", p.issued), expiresAt: time.Now().Add(time.Hour)}
+}
+
+func (p *staticAuthProvider) Authenticate(client *gophercloud.ProviderClient) (gophercloud.AuthResult, gophercloud.EndpointLocator, error) {
+	locator := func(opts gophercloud.EndpointOpts) (string, error) {
+		return th.Endpoint() + opts.Type + "/", nil
+	}
+	return p.issue(), locator, nil
+}
+
+func (p *staticAuthProvider) CanReauth() bool {
+	return true
+}
+
+func (p *staticAuthProvider) Refresh(client *gophercloud.ProviderClient) (gophercloud.AuthResult, error) {
+	p.mut.Lock()
+	p.throwaway = client.IsThrowaway()
+	p.mut.Unlock()
+	return p.issue(), nil
+}
+
+func (p *staticAuthProvider) Revoke(client *gophercloud.ProviderClient, result gophercloud.AuthResult) error {
+	id, _ := result.ExtractTokenID()
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	p.revoked = append(p.revoked, id)
+	return nil
+}
+
+func TestAuthenticateWithProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/compute/servers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	provider := new(staticAuthProvider)
+	client := new(gophercloud.ProviderClient)
+	err := openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "token-1", client.Token())
+
+	compute, err := openstack.NewComputeV2(client, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, th.Endpoint()+"compute/", compute.Endpoint)
+
+	// The 401 response makes the client refresh its token.
+	_, err = compute.Get(compute.ServiceURL("servers"), nil, &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "token-2", client.Token())
+	th.AssertEquals(t, true, provider.throwaway)
+
+	err = openstack.RevokeToken(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"token-2"}, provider.revoked)
+	th.AssertEquals(t, "", client.Token())
+	th.AssertEquals(t, true, client.ReauthFunc == nil)
+}
+
+func TestRevokeTokenV3(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleV3Versions(t)
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			w.Header().Add("X-Subject-Token", "my-token")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{ "token": { "expires_at": "2030-01-01T00:00:00.000000Z", "catalog": [] } }`)
+		case "DELETE":
+			th.TestHeader(t, r, "X-Auth-Token", "my-token")
+			th.TestHeader(t, r, "X-Subject-Token", "my-token")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	options := gophercloud.AuthOptions{
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "default",
+		IdentityEndpoint: th.Endpoint(),
+	}
+	cache := gophercloud.NewMemoryTokenCache()
+
+	client, err := openstack.NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	client.TokenCache = cache
+
+	provider := openstack.NewAuthProvider(options)
+	err = openstack.AuthenticateWithProvider(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "my-token", client.Token())
+
+	key := gophercloud.TokenCacheKey(options)
+	cached, err := cache.Get(key)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "my-token", cached.ID)
+
+	err = openstack.RevokeToken(client, provider)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", client.Token())
+
+	cached, err = cache.Get(key)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, cached == nil)
+}
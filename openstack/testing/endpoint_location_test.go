@@ -84,8 +84,9 @@ func TestV2EndpointNone(t *testing.T) {
 		Type:         "nope",
 		Availability: gophercloud.AvailabilityPublic,
 	})
-	expected := &gophercloud.ErrEndpointNotFound{}
-	th.CheckEquals(t, expected.Error(), actual.Error())
+	expected := `No suitable endpoint could be found in the service catalog for service type "nope", interface "public". ` +
+		`The catalog contains: same "same" (same, different); same "different" (same, different); different "different" (same, different).`
+	th.CheckEquals(t, expected, actual.Error())
 }
 
 func TestV2EndpointMultiple(t *testing.T) {
@@ -228,8 +229,15 @@ func TestV3EndpointNone(t *testing.T) {
 		Type:         "nope",
 		Availability: gophercloud.AvailabilityPublic,
 	})
-	expected := &gophercloud.ErrEndpointNotFound{}
-	th.CheckEquals(t, expected.Error(), actual.Error())
+	err, ok := actual.(*gophercloud.ErrEndpointNotFound)
+	th.AssertEquals(t, true, ok)
+	th.CheckDeepEquals(t, []string{"nope"}, err.Types)
+	th.CheckDeepEquals(t, []string{
+		`same "same" (same public, same admin, same internal, different public)`,
+		`same "different" (same public, different public)`,
+		`different "different" (same public, different public)`,
+		`someother "someother" (someother public, someother admin, someother internal)`,
+	}, err.Catalog)
 }
 
 func TestV3EndpointMultiple(t *testing.T) {
@@ -271,3 +279,149 @@ func TestV3EndpointWithRegionID(t *testing.T) {
 		th.CheckEquals(t, expected, actual)
 	}
 }
+
+var aliasCatalog3 = tokens3.ServiceCatalog{
+	Entries: []tokens3.CatalogEntry{
+		tokens3.CatalogEntry{
+			Type: "block-storage",
+			Name: "cinder",
+			Endpoints: []tokens3.Endpoint{
+				tokens3.Endpoint{
+					Region:    "RegionOne",
+					Interface: "public",
+					URL:       "https://block-storage.example.com/v3",
+				},
+			},
+		},
+		tokens3.CatalogEntry{
+			Type: "volumev2",
+			Name: "cinderv2",
+			Endpoints: []tokens3.Endpoint{
+				tokens3.Endpoint{
+					Region:    "RegionOne",
+					Interface: "public",
+					URL:       "https://volumev2.example.com/v2",
+				},
+			},
+		},
+		tokens3.CatalogEntry{
+			Type: "shared-file-system",
+			Name: "manila",
+			Endpoints: []tokens3.Endpoint{
+				tokens3.Endpoint{
+					Region:    "RegionOne",
+					Interface: "public",
+					URL:       "https://shared-file-system.example.com/v2",
+				},
+			},
+		},
+	},
+}
+
+func TestServiceTypeAliases(t *testing.T) {
+	th.CheckDeepEquals(t, []string{"volumev3", "block-storage", "block-store"}, openstack.ServiceTypeAliases("volumev3"))
+	th.CheckDeepEquals(t, []string{"block-storage", "volumev3", "block-store"}, openstack.ServiceTypeAliases("block-storage"))
+	th.CheckDeepEquals(t, []string{"volumev2"}, openstack.ServiceTypeAliases("volumev2"))
+	th.CheckDeepEquals(t, []string{"compute"}, openstack.ServiceTypeAliases("compute"))
+}
+
+func TestV3EndpointAlias(t *testing.T) {
+	actual, err := openstack.V3EndpointURL(&aliasCatalog3, gophercloud.EndpointOpts{
+		Type:         "volumev3",
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://block-storage.example.com/v3/", actual)
+
+	actual, err = openstack.V3EndpointURL(&aliasCatalog3, gophercloud.EndpointOpts{
+		Type:         "sharev2",
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://shared-file-system.example.com/v2/", actual)
+
+	// The requested type takes precedence over its aliases.
+	actual, err = openstack.V3EndpointURL(&aliasCatalog3, gophercloud.EndpointOpts{
+		Type:         "volumev2",
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://volumev2.example.com/v2/", actual)
+}
+
+func TestV3EndpointAliasNone(t *testing.T) {
+	_, actual := openstack.V3EndpointURL(&aliasCatalog3, gophercloud.EndpointOpts{
+		Type:         "workflowv2",
+		Region:       "RegionOne",
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	expected := `No suitable endpoint could be found in the service catalog for service type "workflowv2" (or its aliases workflow), ` +
+		`region "RegionOne", interface "public". The catalog contains: block-storage "cinder" (RegionOne public); ` +
+		`volumev2 "cinderv2" (RegionOne public); shared-file-system "manila" (RegionOne public).`
+	th.CheckEquals(t, expected, actual.Error())
+}
+
+func TestV2EndpointAlias(t *testing.T) {
+	catalog := tokens2.ServiceCatalog{
+		Entries: []tokens2.CatalogEntry{
+			tokens2.CatalogEntry{
+				Type: "volumev3",
+				Name: "cinderv3",
+				Endpoints: []tokens2.Endpoint{
+					tokens2.Endpoint{
+						Region:    "RegionOne",
+						PublicURL: "https://volumev3.example.com/v3",
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := openstack.V2EndpointURL(&catalog, gophercloud.EndpointOpts{
+		Type:         "block-storage",
+		Availability: gophercloud.AvailabilityPublic,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://volumev3.example.com/v3/", actual)
+}
+
+func TestEndpointOverrides(t *testing.T) {
+	eo := gophercloud.EndpointOpts{
+		Type:         "volumev3",
+		Availability: gophercloud.AvailabilityPublic,
+		EndpointOverrides: map[string]string{
+			"block-storage": "https://override.example.com/v3",
+		},
+	}
+
+	actual, err := openstack.V3EndpointURL(&aliasCatalog3, eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://override.example.com/v3/", actual)
+
+	actual, err = openstack.V2EndpointURL(&catalog2, eo)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://override.example.com/v3/", actual)
+
+	// Overrides are honored even when the catalog is unavailable.
+	provider, err := openstack.NewClient("https://identity.example.com/")
+	th.AssertNoErr(t, err)
+	client, err := openstack.NewBlockStorageV3(provider, gophercloud.EndpointOpts{
+		EndpointOverrides: map[string]string{
+			"volumev3": "https://override.example.com/v3",
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://override.example.com/v3/", client.Endpoint)
+	th.CheckEquals(t, "volumev3", client.Type)
+}
+
+func TestEndpointOverridesFromEnv(t *testing.T) {
+	t.Setenv("OS_BLOCK_STORAGE_ENDPOINT_OVERRIDE", "https://block-storage.example.com/v3")
+	t.Setenv("OS_COMPUTE_ENDPOINT_OVERRIDE", "https://compute.example.com/v2.1")
+	t.Setenv("OS_ENDPOINT_OVERRIDE", "https://ignored.example.com")
+
+	overrides := openstack.EndpointOverridesFromEnv()
+	th.CheckEquals(t, "https://block-storage.example.com/v3", overrides["block-storage"])
+	th.CheckEquals(t, "https://compute.example.com/v2.1", overrides["compute"])
+	th.CheckEquals(t, 2, len(overrides))
+}
@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func handleV3Versions(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `
+			{
+				"versions": {
+					"values": [
+						{
+							"status": "stable",
+							"id": "v3.0",
+							"links": [
+								{ "href": "%s", "rel": "self" }
+							]
+						}
+					]
+				}
+			}
+		`, th.Endpoint()+"v3/")
+	})
+}
+
+func TestAuthenticateTokenCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleV3Versions(t)
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	created := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		created++
+
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("token-%d", created))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+			{
+				"token": {
+					"expires_at": "%s",
+					"catalog": [
+						{
+							"type": "compute",
+							"name": "nova",
+							"endpoints": [
+								{ "id": "1", "interface": "public", "region": "RegionOne", "url": "https://compute.example.com/" }
+							]
+						}
+					]
+				}
+			}
+		`, expiresAt.Format("2006-01-02T15:04:05.000000Z"))
+	})
+
+	options := gophercloud.AuthOptions{
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "default",
+		TenantName:       "project",
+		IdentityEndpoint: th.Endpoint(),
+	}
+	cache := gophercloud.NewMemoryTokenCache()
+
+	for i := 0; i < 2; i++ {
+		client, err := openstack.NewClient(options.IdentityEndpoint)
+		th.AssertNoErr(t, err)
+		client.TokenCache = cache
+		client.TokenRefreshBefore = 5 * time.Minute
+
+		err = openstack.Authenticate(client, options)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "token-1", client.Token())
+
+		compute, err := openstack.NewComputeV2(client, gophercloud.EndpointOpts{Region: "RegionOne"})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "https://compute.example.com/", compute.Endpoint)
+	}
+	th.AssertEquals(t, 1, created)
+
+	// A token that expires within TokenRefreshBefore is not reused.
+	client, err := openstack.NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	client.TokenCache = cache
+	client.TokenRefreshBefore = 2 * time.Hour
+
+	err = openstack.Authenticate(client, options)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "token-2", client.Token())
+	th.AssertEquals(t, 2, created)
+
+	// A wrong password does not get the cached token.
+	wrongPassword := options
+	wrongPassword.Password = "wrong"
+	client, err = openstack.NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	client.TokenCache = cache
+
+	err = openstack.Authenticate(client, wrongPassword)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "token-3", client.Token())
+
+	// Different users do not share tokens.
+	options.Username = "other"
+	client, err = openstack.NewClient(options.IdentityEndpoint)
+	th.AssertNoErr(t, err)
+	client.TokenCache = cache
+
+	err = openstack.Authenticate(client, options)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "token-4", client.Token())
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	tokens2 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v2/tokens"
+	tokens3 "github.com/yogeshwargnanasekaran/gophercloud/openstack/identity/v3/tokens"
+)
+
+// useCachedToken sets the token stored under key in the TokenCache of client,
+// and the corresponding service catalog, on client. It returns false if there
+// is no such token, if it expires within client.TokenRefreshBefore, or if
+// provider does not hold the secrets the token was obtained with.
+func useCachedToken(client *gophercloud.ProviderClient, provider gophercloud.AuthProvider, key string) bool {
+	cached, err := client.TokenCache.Get(key)
+	if err != nil || cached == nil {
+		return false
+	}
+	if verifier, ok := provider.(gophercloud.TokenCacheVerifier); ok && !verifier.VerifyTokenCacheSecret(cached.SecretHash) {
+		return false
+	}
+	if time.Until(cached.ExpiresAt) <= client.TokenRefreshBefore {
+		return false
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(cached.Body, &body); err != nil {
+		return false
+	}
+
+	switch cached.IdentityVersion {
+	case v2:
+		result := tokens2.CreateResult{Result: gophercloud.Result{Body: body}}
+		catalog, err := result.ExtractServiceCatalog()
+		if err != nil {
+			return false
+		}
+		if err := client.SetTokenAndAuthResult(result); err != nil {
+			return false
+		}
+		client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+			return V2EndpointURL(catalog, opts)
+		}
+	case v3:
+		var result tokens3.CreateResult
+		result.Body = body
+		result.Header = http.Header{"X-Subject-Token": []string{cached.ID}}
+		catalog, err := result.ExtractServiceCatalog()
+		if err != nil {
+			return false
+		}
+		if err := client.SetTokenAndAuthResult(result); err != nil {
+			return false
+		}
+		client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+			return V3EndpointURL(catalog, opts)
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+// storeToken stores the token client has authenticated with in its
+// TokenCache, under key.
+func storeToken(client *gophercloud.ProviderClient, provider gophercloud.AuthProvider, key string) {
+	var version string
+	var body interface{}
+	var expiresAt time.Time
+	var err error
+
+	switch r := client.GetAuthResult().(type) {
+	case tokens2.CreateResult:
+		version, body = v2, r.Body
+		expiresAt, err = r.ExtractExpiresAt()
+	case tokens3.CreateResult:
+		version, body = v3, r.Body
+		expiresAt, err = r.ExtractExpiresAt()
+	default:
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return
+	}
+
+	var secretHash string
+	if verifier, ok := provider.(gophercloud.TokenCacheVerifier); ok {
+		secretHash, err = verifier.TokenCacheSecretHash()
+		if err != nil {
+			return
+		}
+	}
+
+	_ = client.TokenCache.Set(key, &gophercloud.CachedToken{
+		ID:              client.Token(),
+		ExpiresAt:       expiresAt,
+		IdentityVersion: version,
+		Body:            b,
+		SecretHash:      secretHash,
+	})
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+)
+
+// GetSupportedMicroversions queries the version document of the service that
+// client points to, and returns the oldest and newest microversions supported
+// by the API version client uses. It works with the services that advertise
+// microversions in their version document, such as Compute, Block Storage,
+// Shared File Systems and Bare Metal.
+//
+// An ErrMicroversionNotSupported error is returned if the API version client
+// uses does not support microversions.
+func GetSupportedMicroversions(client *gophercloud.ServiceClient) (string, string, error) {
+	type linkResp struct {
+		Href string `json:"href"`
+		Rel  string `json:"rel"`
+	}
+
+	type valueResp struct {
+		ID         string     `json:"id"`
+		Status     string     `json:"status"`
+		Version    string     `json:"version"`
+		MinVersion string     `json:"min_version"`
+		Links      []linkResp `json:"links"`
+	}
+
+	type response struct {
+		Version        *valueResp  `json:"version"`
+		Versions       []valueResp `json:"versions"`
+		DefaultVersion *valueResp  `json:"default_version"`
+	}
+
+	base, err := BaseEndpoint(client.Endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	base = gophercloud.NormalizeURL(base)
+
+	var resp response
+	_, err = client.Request("GET", base, &gophercloud.RequestOpts{
+		JSONResponse: &resp,
+		OkCodes:      []int{200, 300},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	values := resp.Versions
+	if resp.Version != nil {
+		values = append(values, *resp.Version)
+	}
+	if resp.DefaultVersion != nil {
+		values = append(values, *resp.DefaultVersion)
+	}
+
+	// Prefer the version whose endpoint the client uses. Otherwise, pick the
+	// version that supports the newest microversion.
+	endpoint := gophercloud.NormalizeURL(client.Endpoint)
+	var chosen *valueResp
+	for i, value := range values {
+		for _, link := range value.Links {
+			if link.Rel == "self" && strings.HasPrefix(endpoint, gophercloud.NormalizeURL(link.Href)) {
+				if value.Version == "" {
+					return "", "", gophercloud.ErrMicroversionNotSupported{ServiceType: client.Type}
+				}
+				return value.MinVersion, value.Version, nil
+			}
+		}
+		if value.Version == "" || !goodStatus[strings.ToLower(value.Status)] {
+			continue
+		}
+		if chosen == nil {
+			chosen = &values[i]
+			continue
+		}
+		if c, err := gophercloud.CompareMicroversions(value.Version, chosen.Version); err == nil && c > 0 {
+			chosen = &values[i]
+		}
+	}
+
+	if chosen == nil {
+		return "", "", gophercloud.ErrMicroversionNotSupported{ServiceType: client.Type}
+	}
+	return chosen.MinVersion, chosen.Version, nil
+}
+
+// NegotiateMicroversion records the microversions supported by the service
+// that client points to on client, and sets client.Microversion to the
+// highest of them that is not newer than upTo. If upTo is empty, the newest
+// supported microversion is used.
+//
+// An ErrMicroversionNotSupported error is returned if the service only
+// supports microversions newer than upTo, or no microversions at all:
+//
+//	client, err := openstack.NewComputeV2(provider, gophercloud.EndpointOpts{})
+//	err = utils.NegotiateMicroversion(client, "2.79")
+//
+//	// Later, before using a feature introduced in microversion 2.60:
+//	if err := client.RequireMicroversion("2.60"); err != nil {
+//		return err
+//	}
+func NegotiateMicroversion(client *gophercloud.ServiceClient, upTo string) error {
+	min, max, err := GetSupportedMicroversions(client)
+	if err != nil {
+		if e, ok := err.(gophercloud.ErrMicroversionNotSupported); ok {
+			e.Required = upTo
+			return e
+		}
+		return err
+	}
+
+	client.MinMicroversion = min
+	client.MaxMicroversion = max
+
+	if upTo == "" {
+		client.Microversion = max
+		return nil
+	}
+
+	// The microversion negotiated previously, if any, must not cap the new
+	// one.
+	previous := client.Microversion
+	client.Microversion = ""
+	v, err := client.HighestMicroversion(upTo)
+	if err != nil {
+		client.Microversion = previous
+		return err
+	}
+	client.Microversion = v
+	return nil
+}
@@ -0,0 +1,185 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	"github.com/yogeshwargnanasekaran/gophercloud/openstack/utils"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func setupComputeVersionHandler() {
+	testhelper.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v2.0",
+						"status": "SUPPORTED",
+						"version": "",
+						"min_version": "",
+						"links": [
+							{ "href": "%[1]s/v2/", "rel": "self" }
+						]
+					},
+					{
+						"id": "v2.1",
+						"status": "CURRENT",
+						"version": "2.87",
+						"min_version": "2.1",
+						"links": [
+							{ "href": "%[1]s/v2.1/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`, testhelper.Server.URL)
+	})
+}
+
+func newServiceClient(serviceType, endpoint string) *gophercloud.ServiceClient {
+	return &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       endpoint,
+		Type:           serviceType,
+	}
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionHandler()
+
+	c := newServiceClient("compute", testhelper.Endpoint()+"v2.1/")
+	min, max, err := utils.GetSupportedMicroversions(c)
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "2.1", min)
+	testhelper.AssertEquals(t, "2.87", max)
+
+	// The legacy v2 endpoint does not support microversions.
+	c = newServiceClient("compute", testhelper.Endpoint()+"v2/")
+	_, _, err = utils.GetSupportedMicroversions(c)
+	testhelper.AssertDeepEquals(t, gophercloud.ErrMicroversionNotSupported{ServiceType: "compute"}, err)
+}
+
+func TestGetSupportedMicroversionsProjectEndpoint(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{
+						"id": "v3.0",
+						"status": "CURRENT",
+						"version": "3.60",
+						"min_version": "3.0",
+						"links": [
+							{ "href": "%s/v3/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`, testhelper.Server.URL)
+	})
+
+	c := newServiceClient("volume", testhelper.Endpoint()+"v3/0123456789/")
+	min, max, err := utils.GetSupportedMicroversions(c)
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "3.0", min)
+	testhelper.AssertEquals(t, "3.60", max)
+}
+
+func TestGetSupportedMicroversionsDefaultVersion(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `
+			{
+				"default_version": {
+					"id": "v1",
+					"status": "CURRENT",
+					"version": "1.68",
+					"min_version": "1.1",
+					"links": [
+						{ "href": "http://ironic.example.com/v1/", "rel": "self" }
+					]
+				},
+				"versions": [
+					{
+						"id": "v1",
+						"status": "CURRENT",
+						"version": "1.68",
+						"min_version": "1.1",
+						"links": [
+							{ "href": "http://ironic.example.com/v1/", "rel": "self" }
+						]
+					}
+				]
+			}
+		`)
+	})
+
+	c := newServiceClient("baremetal", testhelper.Endpoint())
+	min, max, err := utils.GetSupportedMicroversions(c)
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "1.1", min)
+	testhelper.AssertEquals(t, "1.68", max)
+}
+
+func TestNegotiateMicroversion(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionHandler()
+
+	c := newServiceClient("compute", testhelper.Endpoint()+"v2.1/")
+	err := utils.NegotiateMicroversion(c, "2.60")
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "2.60", c.Microversion)
+	testhelper.AssertEquals(t, "2.1", c.MinMicroversion)
+	testhelper.AssertEquals(t, "2.87", c.MaxMicroversion)
+
+	err = utils.NegotiateMicroversion(c, "2.100")
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "2.87", c.Microversion)
+
+	err = utils.NegotiateMicroversion(c, "")
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "2.87", c.Microversion)
+
+	err = utils.NegotiateMicroversion(c, "1.5")
+	testhelper.AssertDeepEquals(t, gophercloud.ErrMicroversionNotSupported{
+		ServiceType: "compute",
+		Required:    "1.5",
+		Min:         "2.1",
+		Max:         "2.87",
+	}, err)
+}
+
+func TestNegotiateThenRequireMicroversion(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	setupComputeVersionHandler()
+
+	c := newServiceClient("compute", testhelper.Endpoint()+"v2.1/")
+	err := utils.NegotiateMicroversion(c, "2.79")
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "2.79", c.Microversion)
+
+	testhelper.AssertNoErr(t, c.RequireMicroversion("2.60"))
+
+	// The service supports 2.85, but the requests go out at 2.79.
+	err = c.RequireMicroversion("2.85")
+	testhelper.AssertDeepEquals(t, gophercloud.ErrMicroversionNotSupported{
+		ServiceType: "compute",
+		Required:    "2.85",
+		Min:         "2.1",
+		Max:         "2.87",
+		Current:     "2.79",
+	}, err)
+}
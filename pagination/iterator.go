@@ -0,0 +1,154 @@
+package pagination
+
+// Iterator lazily walks the items of a paginated collection, fetching a page
+// only once every item of the previous page has been consumed:
+//
+//	it := servers.ListIterator(client, nil)
+//	for it.Next() {
+//		server := it.Value()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+//
+// Page bodies are read in full and closed as soon as a page is fetched, so an
+// Iterator can be abandoned at any point without leaking HTTP connections.
+// Close stops the iteration explicitly; it must be called when stopping early
+// with a prefetching Pager, so that the in-flight request is cancelled.
+//
+// An Iterator is not safe for concurrent use.
+type Iterator[T any] struct {
+	pager   Pager
+	extract func(Page) ([]T, error)
+
+	// MaxItems, if positive, caps the number of items the Iterator yields. No
+	// further page is fetched once the cap has been reached.
+	MaxItems int
+
+	nextURL string
+	pending *pendingPage
+	started bool
+	done    bool
+	items   []T
+	current T
+	count   int
+	err     error
+}
+
+// NewIterator returns an Iterator over the items of the pages returned by
+// pager. extract converts a page into its items; it is usually the Extract
+// function of the resource package, for example ports.ExtractPorts.
+func NewIterator[T any](pager Pager, extract func(Page) ([]T, error)) *Iterator[T] {
+	return &Iterator[T]{
+		pager:   pager,
+		extract: extract,
+	}
+}
+
+// Next advances the Iterator to the next item, fetching the next page if
+// needed. It returns false when the collection is exhausted, when MaxItems
+// items have been yielded, after Close, or when an error occurred. Err
+// distinguishes the latter case from the others.
+func (it *Iterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+	if it.MaxItems > 0 && it.count >= it.MaxItems {
+		it.Close()
+		return false
+	}
+
+	for len(it.items) == 0 {
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.current = it.items[0]
+	it.items = it.items[1:]
+	it.count++
+	return true
+}
+
+// Value returns the current item. It is only meaningful after a call to Next
+// returned true.
+func (it *Iterator[T]) Value() T {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Close stops the iteration and cancels the prefetch of the next page, if
+// any. Subsequent calls to Next return false. Close does not reset Err.
+func (it *Iterator[T]) Close() {
+	it.done = true
+	it.items = nil
+	if it.pending != nil {
+		it.pending.cancel()
+		it.pending = nil
+	}
+}
+
+// fetch retrieves the next page and stores its items. It returns false when
+// there is no next page or an error occurred.
+func (it *Iterator[T]) fetch() bool {
+	if it.pager.Err != nil {
+		it.err = it.pager.Err
+		return false
+	}
+
+	url := it.nextURL
+	if !it.started {
+		url = it.pager.initialURL
+		it.started = true
+	}
+	if url == "" {
+		it.Close()
+		return false
+	}
+
+	var page Page
+	var err error
+	if it.pending != nil {
+		page, err = it.pending.wait()
+		it.pending = nil
+	} else {
+		page, err = it.pager.fetchNextPage(url)
+	}
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	empty, err := page.IsEmpty()
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if empty {
+		it.Close()
+		return false
+	}
+
+	items, err := it.extract(page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.nextURL, err = page.NextPageURL()
+	if err != nil {
+		it.err = err
+		return false
+	}
+	if it.pager.prefetch && it.nextURL != "" && (it.MaxItems <= 0 || it.count+len(items) < it.MaxItems) {
+		it.pending = it.pager.startFetch(it.nextURL)
+	}
+
+	it.items = items
+	return true
+}
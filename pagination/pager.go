@@ -1,6 +1,7 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -43,6 +44,10 @@ type Pager struct {
 
 	firstPage Page
 
+	// prefetch enables fetching the next page while the current one is
+	// handled. See WithPrefetch.
+	prefetch bool
+
 	Err error
 
 	// Headers supplies additional HTTP headers to populate on each paged request.
@@ -69,6 +74,16 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 	}
 }
 
+// WithContext returns a new Pager whose page requests are bound to ctx.
+// Cancelling ctx aborts the in-flight page request and stops EachPage and
+// AllPages with the context's error.
+func (p Pager) WithContext(ctx context.Context) Pager {
+	if p.client != nil {
+		p.client = p.client.WithContext(ctx)
+	}
+	return p
+}
+
 func (p Pager) fetchNextPage(url string) (Page, error) {
 	resp, err := Request(p.client, p.Headers, url)
 	if err != nil {
@@ -90,6 +105,16 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 		return p.Err
 	}
 	currentURL := p.initialURL
+
+	// next is the prefetched page, if any. It is cancelled if the iteration
+	// stops before it is used.
+	var next *pendingPage
+	defer func() {
+		if next != nil {
+			next.cancel()
+		}
+	}()
+
 	for {
 		var currentPage Page
 
@@ -97,6 +122,13 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 		if p.firstPage != nil {
 			currentPage = p.firstPage
 			p.firstPage = nil
+		} else if next != nil {
+			var err error
+			currentPage, err = next.wait()
+			next = nil
+			if err != nil {
+				return err
+			}
 		} else {
 			var err error
 			currentPage, err = p.fetchNextPage(currentURL)
@@ -113,6 +145,16 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 			return nil
 		}
 
+		if p.prefetch {
+			nextURL, err := currentPage.NextPageURL()
+			if err != nil {
+				return err
+			}
+			if nextURL != "" {
+				next = p.startFetch(nextURL)
+			}
+		}
+
 		ok, err := handler(currentPage)
 		if err != nil {
 			return err
@@ -148,9 +190,12 @@ func (p Pager) AllPages() (Page, error) {
 	// that type.
 	pageType := reflect.TypeOf(firstPage)
 
-	// if it's a single page, just return the firstPage (first page)
+	// if it's a single page, just return the firstPage (first page), unless
+	// the page type overrides NextPageURL and links to more pages.
 	if _, found := pageType.FieldByName("SinglePageBase"); found {
-		return firstPage, nil
+		if next, err := firstPage.NextPageURL(); err != nil || next == "" {
+			return firstPage, nil
+		}
 	}
 
 	// store the first page to avoid getting it twice
@@ -0,0 +1,123 @@
+package pagination
+
+import (
+	"context"
+)
+
+// WithPrefetch returns a new Pager that requests the next page while the
+// current one is being handled. EachPage, AllPages, Iterator and Stream all
+// benefit from it.
+//
+// Since the URL of a page is only known once the previous page has been
+// received, at most one page is fetched ahead of time. An in-flight prefetch
+// is cancelled as soon as the iteration stops early.
+func (p Pager) WithPrefetch() Pager {
+	p.prefetch = true
+	return p
+}
+
+// pageFetch is the outcome of a page request.
+type pageFetch struct {
+	page Page
+	err  error
+}
+
+// pendingPage is a page request issued in the background.
+type pendingPage struct {
+	result chan pageFetch
+	cancel context.CancelFunc
+}
+
+// startFetch requests the page at url in the background. The request is bound
+// to a context derived from the one of the Pager's client, so that it can be
+// aborted through cancel.
+func (p Pager) startFetch(url string) *pendingPage {
+	parent := p.client.RequestContext()
+	if parent == nil && p.client.ProviderClient != nil {
+		parent = p.client.ProviderClient.Context
+	}
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+
+	q := p
+	q.client = p.client.WithContext(ctx)
+
+	// The channel is buffered so that the goroutine never blocks, even if
+	// the result is never waited for.
+	pending := &pendingPage{
+		result: make(chan pageFetch, 1),
+		cancel: cancel,
+	}
+	go func() {
+		page, err := q.fetchNextPage(url)
+		pending.result <- pageFetch{page: page, err: err}
+	}()
+	return pending
+}
+
+// wait blocks until the page has been received.
+func (f *pendingPage) wait() (Page, error) {
+	r := <-f.result
+	f.cancel()
+	return r.page, r.err
+}
+
+// Stream walks the items of the pages returned by pager in the background and
+// sends them on the returned items channel, which is closed once the
+// collection is exhausted or the walk stops. The error channel then yields the
+// error that stopped the walk, if any, and is closed.
+//
+// Unlike AllPages, Stream never holds more than the current page, the page
+// being prefetched if prefetching is enabled, and buffer items in memory. A
+// negative buffer is treated as 0, making the items channel unbuffered.
+// Cancelling ctx aborts the in-flight page request and stops the walk with
+// ctx's error; callers that stop reading items early must cancel ctx so that
+// the background goroutine exits:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	items, errs := pagination.Stream(ctx, ports.List(client, nil).WithPrefetch(), ports.ExtractPorts, 100)
+//	for port := range items {
+//		// ...
+//	}
+//	if err := <-errs; err != nil {
+//		// ...
+//	}
+func Stream[T any](ctx context.Context, pager Pager, extract func(Page) ([]T, error), buffer int) (<-chan T, <-chan error) {
+	if buffer < 0 {
+		buffer = 0
+	}
+	items := make(chan T, buffer)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(items)
+
+		err := pager.WithContext(ctx).EachPage(func(page Page) (bool, error) {
+			extracted, err := extract(page)
+			if err != nil {
+				return false, err
+			}
+			for _, item := range extracted {
+				if err := ctx.Err(); err != nil {
+					return false, err
+				}
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return items, errs
+}
@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestIteratorLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+
+	// An exhausted iterator stays exhausted.
+	testhelper.AssertEquals(t, false, it.Next())
+}
+
+func TestIteratorFetchesLazily(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	var requests []string
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "page1")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, "page2")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [3, 4], "links": { "next": null } }`)
+	})
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	}
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", createPage)
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+
+	testhelper.AssertEquals(t, true, it.Next())
+	testhelper.AssertEquals(t, 1, it.Value())
+	testhelper.AssertEquals(t, true, it.Next())
+	testhelper.AssertEquals(t, 2, it.Value())
+	testhelper.AssertDeepEquals(t, []string{"page1"}, requests)
+
+	testhelper.AssertEquals(t, true, it.Next())
+	testhelper.AssertEquals(t, 3, it.Value())
+	testhelper.AssertDeepEquals(t, []string{"page1", "page2"}, requests)
+
+	// Stopping early fetches nothing more.
+	it.Close()
+	testhelper.AssertEquals(t, false, it.Next())
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []string{"page1", "page2"}, requests)
+}
+
+func TestIteratorMaxItems(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	it.MaxItems = 4
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4}, actual)
+}
+
+func TestIteratorMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	var actual []string
+	it := pagination.NewIterator(pager, ExtractMarkerStrings)
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}, actual)
+}
+
+func TestIteratorSingle(t *testing.T) {
+	pager := setupSinglePaged()
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	it := pagination.NewIterator(pager, ExtractSingleInts)
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3}, actual)
+}
+
+func TestIteratorError(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	calls := 0
+	extract := func(page pagination.Page) ([]int, error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("boom")
+		}
+		return ExtractLinkedInts(page)
+	}
+
+	var actual []int
+	it := pagination.NewIterator(pager, extract)
+	for it.Next() {
+		actual = append(actual, it.Value())
+	}
+	testhelper.AssertEquals(t, "boom", it.Err().Error())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3}, actual)
+}
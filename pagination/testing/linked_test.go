@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -98,6 +99,26 @@ func TestEnumerateLinked(t *testing.T) {
 	}
 }
 
+func TestEnumerateLinkedWithContext(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	callCount := 0
+	err := pager.WithContext(ctx).EachPage(func(page pagination.Page) (bool, error) {
+		callCount++
+		// Cancelling the context aborts fetching the following page.
+		cancel()
+		return true, nil
+	})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	testhelper.AssertEquals(t, 1, callCount)
+}
+
 func TestAllPagesLinked(t *testing.T) {
 	pager := createLinked(t)
 	defer testhelper.TeardownHTTP()
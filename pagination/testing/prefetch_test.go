@@ -0,0 +1,149 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud/pagination"
+	"github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestEachPagePrefetch(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	page2Requested := make(chan struct{})
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2, 3], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		close(page2Requested)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [4, 5, 6], "links": { "next": null } }`)
+	})
+
+	createPage := func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	}
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", createPage).WithPrefetch()
+
+	var actual []int
+	err := pager.EachPage(func(page pagination.Page) (bool, error) {
+		ints, err := ExtractLinkedInts(page)
+		if err != nil {
+			return false, err
+		}
+		if ints[0] == 1 {
+			// The second page is requested while the first one is handled.
+			select {
+			case <-page2Requested:
+			case <-time.After(5 * time.Second):
+				t.Fatal("the next page was not prefetched")
+			}
+		}
+		actual = append(actual, ints...)
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5, 6}, actual)
+}
+
+func TestAllPagesPrefetch(t *testing.T) {
+	pager := createLinked(t).WithPrefetch()
+	defer testhelper.TeardownHTTP()
+
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := ExtractLinkedInts(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestIteratorPrefetch(t *testing.T) {
+	pager := createLinked(t).WithPrefetch()
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	it := pagination.NewIterator(pager, ExtractLinkedInts)
+	for it.Next() {
+		actual = append(actual, it.Value())
+		if len(actual) == 5 {
+			it.Close()
+		}
+	}
+	testhelper.AssertNoErr(t, it.Err())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5}, actual)
+}
+
+func TestStream(t *testing.T) {
+	pager := createLinked(t).WithPrefetch()
+	defer testhelper.TeardownHTTP()
+
+	items, errs := pagination.Stream(context.Background(), pager, ExtractLinkedInts, 2)
+
+	var actual []int
+	for i := range items {
+		actual = append(actual, i)
+	}
+	testhelper.AssertNoErr(t, <-errs)
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestStreamNegativeBuffer(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	items, errs := pagination.Stream(context.Background(), pager, ExtractLinkedInts, -1)
+
+	var actual []int
+	for i := range items {
+		actual = append(actual, i)
+	}
+	testhelper.AssertNoErr(t, <-errs)
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestStreamCancel(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	items, errs := pagination.Stream(ctx, pager, ExtractLinkedInts, 0)
+
+	testhelper.AssertEquals(t, 1, <-items)
+	cancel()
+
+	testhelper.AssertEquals(t, context.Canceled, <-errs)
+	_, ok := <-items
+	testhelper.AssertEquals(t, false, ok)
+}
+
+func TestStreamError(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	extract := func(page pagination.Page) ([]int, error) {
+		ints, err := ExtractLinkedInts(page)
+		if err == nil && ints[0] == 4 {
+			return nil, errors.New("boom")
+		}
+		return ints, err
+	}
+
+	items, errs := pagination.Stream(context.Background(), pager, extract, 0)
+
+	var actual []int
+	for i := range items {
+		actual = append(actual, i)
+	}
+	testhelper.AssertEquals(t, "boom", (<-errs).Error())
+	testhelper.AssertDeepEquals(t, []int{1, 2, 3}, actual)
+}
@@ -10,11 +10,18 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
 const DefaultUserAgent = "gophercloud/2.0.0"
 
+// GlobalRequestIDHeader is the header through which a caller-chosen request ID
+// is propagated to OpenStack services. Services log it alongside their own
+// request IDs and forward it to the services they call on behalf of the
+// request.
+const GlobalRequestIDHeader = "X-OpenStack-Request-ID"
+
 // UserAgent represents a User-Agent header.
 type UserAgent struct {
 	// prepend is the slice of User-Agent strings to prepend to DefaultUserAgent.
@@ -77,7 +84,34 @@ type ProviderClient struct {
 	// with the token and reauth func zeroed. Such client can be used to perform reauthorization.
 	Throwaway bool
 
-	// Context is the context passed to the HTTP request.
+	// GlobalRequestID, if set, is sent as the X-OpenStack-Request-ID header
+	// with every request, so that a single logical operation spanning several
+	// services can be traced in their logs. OpenStack services only accept
+	// values of the form "req-" followed by a UUID. A request may override it
+	// through RequestOpts.MoreHeaders.
+	GlobalRequestID string
+
+	// Middleware is the chain of middleware every request made through this
+	// client passes through. See Middleware and Use.
+	Middleware []Middleware
+
+	// RetryPolicy, if set, makes the client retry requests that failed with a
+	// 429 or 503 response or a transient network error. See RetryPolicy.
+	RetryPolicy *RetryPolicy
+
+	// TokenCache, if set, is consulted by openstack.Authenticate and
+	// openstack.AuthenticateWithProvider before they request a new token, and
+	// receives the tokens they obtain. See TokenCache.
+	TokenCache TokenCache
+
+	// TokenRefreshBefore, if positive, makes the client reauthenticate in the
+	// background this long before its token expires, so that requests do not
+	// fail because of an expired token. See ScheduleTokenRefresh.
+	TokenRefreshBefore time.Duration
+
+	// Context is the context passed to the HTTP request. It is used for every
+	// request issued by this client unless a request supplies its own context
+	// through RequestOpts.Context.
 	Context context.Context
 
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
@@ -89,6 +123,10 @@ type ProviderClient struct {
 	reauthmut *reauthlock
 
 	authResult AuthResult
+
+	// refreshTimer is the timer of the scheduled background token refresh, if
+	// any. It is protected by mut.
+	refreshTimer *time.Timer
 }
 
 // reauthlock represents a set of attributes used to help in the reauthentication process.
@@ -138,10 +176,20 @@ func (client *ProviderClient) AuthenticatedHeaders() (m map[string]string) {
 		}
 	}
 	t := client.Token()
+	if r, ok := client.GetAuthResult().(AuthHeadersResult); ok {
+		m = make(map[string]string)
+		for k, v := range r.AuthHeaders() {
+			m[k] = v
+		}
+	}
 	if t == "" {
 		return
 	}
-	return map[string]string{"X-Auth-Token": t}
+	if m == nil {
+		m = make(map[string]string, 1)
+	}
+	m["X-Auth-Token"] = t
+	return
 }
 
 // UseTokenLock creates a mutex that is used to allow safe concurrent access to the auth token.
@@ -254,7 +302,11 @@ func (client *ProviderClient) Reauthenticate(previousToken string) error {
 	}
 
 	if client.reauthmut == nil {
-		return client.ReauthFunc()
+		err := client.ReauthFunc()
+		if err == nil {
+			client.ScheduleTokenRefresh()
+		}
+		return err
 	}
 
 	future := newReauthFuture()
@@ -276,6 +328,9 @@ func (client *ProviderClient) Reauthenticate(previousToken string) error {
 	var err error
 	if previousToken == "" || client.TokenID == previousToken {
 		err = client.ReauthFunc()
+		if err == nil {
+			client.ScheduleTokenRefresh()
+		}
 	} else {
 		err = nil
 	}
@@ -314,6 +369,9 @@ type RequestOpts struct {
 	// KeepResponseBody specifies whether to keep the HTTP response body. Usually used, when the HTTP
 	// response body is considered for further use. Valid when JSONResponse is nil.
 	KeepResponseBody bool
+	// Context, if provided, is attached to the HTTP request instead of the ProviderClient's
+	// Context. Cancelling it aborts the in-flight request, including any reauthentication retry.
+	Context context.Context
 }
 
 // requestState contains temporary state for a single ProviderClient.Request() call.
@@ -323,6 +381,9 @@ type requestState struct {
 	// reauthenticate, but keep getting 401 responses with the fresh token, reauthenticating some more
 	// will just get us into an infinite loop.
 	hasReauthenticated bool
+
+	// retries counts how many times this request has been retried according to the RetryPolicy.
+	retries int
 }
 
 var applicationJSON = "application/json"
@@ -330,9 +391,11 @@ var applicationJSON = "application/json"
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
 // header will automatically be provided.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
-	return client.doRequest(method, url, options, &requestState{
-		hasReauthenticated: false,
-	})
+	return client.dispatch(&RequestInfo{
+		Method:  method,
+		URL:     url,
+		Options: options,
+	}, nil)
 }
 
 func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
@@ -369,7 +432,9 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	if err != nil {
 		return nil, err
 	}
-	if client.Context != nil {
+	if options.Context != nil {
+		req = req.WithContext(options.Context)
+	} else if client.Context != nil {
 		req = req.WithContext(client.Context)
 	}
 
@@ -383,6 +448,10 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	// Set the User-Agent header
 	req.Header.Set("User-Agent", client.UserAgent.Join())
 
+	if client.GlobalRequestID != "" {
+		req.Header.Set(GlobalRequestIDHeader, client.GlobalRequestID)
+	}
+
 	if options.MoreHeaders != nil {
 		for k, v := range options.MoreHeaders {
 			if v != "" {
@@ -403,6 +472,12 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	// Issue the request.
 	resp, err := client.HTTPClient.Do(req)
 	if err != nil {
+		if delay, ok := client.retryDelay(req.Context(), method, url, options, state, nil, err); ok {
+			if err := client.prepareRetry(req.Context(), delay, options, state); err != nil {
+				return nil, err
+			}
+			return client.doRequest(method, url, options, state)
+		}
 		return nil, err
 	}
 
@@ -422,6 +497,15 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	}
 
 	if !ok {
+		if delay, retry := client.retryDelay(req.Context(), method, url, options, state, resp, nil); retry {
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+			if err := client.prepareRetry(req.Context(), delay, options, state); err != nil {
+				return nil, err
+			}
+			return client.doRequest(method, url, options, state)
+		}
+
 		body, _ := ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
 		respErr := ErrUnexpectedResponseCode{
@@ -38,6 +38,22 @@ type Result struct {
 	Err error
 }
 
+// RequestID returns the ID the service assigned to the request, as reported by
+// the X-Openstack-Request-Id header or, for older Compute deployments, the
+// X-Compute-Request-Id header. It returns an empty string if the response
+// carried neither header or if no response was received.
+func (r Result) RequestID() string {
+	return requestID(r.Header)
+}
+
+// requestID returns the OpenStack request ID found in h, if any.
+func requestID(h http.Header) string {
+	if v := h.Get("X-Openstack-Request-Id"); v != "" {
+		return v
+	}
+	return h.Get("X-Compute-Request-Id")
+}
+
 // ExtractInto allows users to provide an object into which `Extract` will extract
 // the `Result.Body`. This would be useful for OpenStack providers that have
 // different fields in the response object than OpenStack proper.
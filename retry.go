@@ -0,0 +1,242 @@
+package gophercloud
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy describes how a ProviderClient retries requests that failed
+// because of rate limiting (429), temporary unavailability (503) or a
+// transient network error such as a reset connection.
+//
+// Retries are delayed with an exponential backoff with jitter. When the
+// response carries a Retry-After header, its value is used instead.
+//
+// By default only idempotent requests (GET, HEAD, PUT, DELETE and OPTIONS)
+// are retried. Requests with a RawBody that does not implement io.Seeker are
+// never retried, because the body cannot be replayed.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a single request,
+	// including the first one. A value lower than 2 disables retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry. It doubles on every
+	// following retry. Defaults to one second.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff delay. It does not apply to delays
+	// requested by the server through Retry-After. Defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// StatusCodes lists the HTTP status codes that are retried. Defaults to
+	// 429 and 503.
+	StatusCodes []int
+
+	// RetryNonIdempotent allows POST and PATCH requests to be retried as well.
+	RetryNonIdempotent bool
+
+	// ShouldRetry, if set, replaces the default decision of whether a failed
+	// attempt is retried. Exactly one of resp and err is non-nil. It is not
+	// consulted once MaxAttempts is reached or the request context is done.
+	ShouldRetry func(method string, resp *http.Response, err error) bool
+
+	// OnRetry, if set, is called before each retry is scheduled.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a retry that is about to happen. It is passed to
+// RetryPolicy.OnRetry.
+type RetryEvent struct {
+	// Method and URL identify the request being retried.
+	Method string
+	URL    string
+
+	// Attempt is the number of the attempt that just failed, starting at 1.
+	Attempt int
+
+	// StatusCode is the status code of the failed attempt, or 0 if the attempt
+	// failed with a network error.
+	StatusCode int
+
+	// Err is the network error of the failed attempt, if any.
+	Err error
+
+	// Delay is how long the client waits before the next attempt.
+	Delay time.Duration
+}
+
+var defaultRetryStatusCodes = []int{429, http.StatusServiceUnavailable}
+
+func (p *RetryPolicy) canRetry(attempt int, method string, options *RequestOpts) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if options.RawBody != nil {
+		if _, ok := options.RawBody.(io.Seeker); !ok {
+			return false
+		}
+	}
+	if p.RetryNonIdempotent {
+		return true
+	}
+	switch method {
+	case "GET", "HEAD", "PUT", "DELETE", "OPTIONS":
+		return true
+	}
+	return false
+}
+
+func (p *RetryPolicy) shouldRetry(method string, resp *http.Response, err error) bool {
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(method, resp, err)
+	}
+	if err != nil {
+		return isTransientNetworkError(err)
+	}
+	codes := p.StatusCodes
+	if codes == nil {
+		codes = defaultRetryStatusCodes
+	}
+	for _, code := range codes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait after the given failed attempt.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = time.Second
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+
+	d := initial
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+
+	// Use "equal jitter": wait at least half of the backoff, plus a random
+	// share of the other half.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// wait blocks for the given delay or until ctx is done.
+func (p *RetryPolicy) wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// isTransientNetworkError reports whether err is a network error that is
+// likely to go away when the request is issued again.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// retryDelay checks whether the failed attempt described by resp or err
+// should be retried and, if so, returns the delay to wait before the next
+// attempt. Exactly one of resp and err is non-nil.
+func (client *ProviderClient) retryDelay(ctx context.Context, method, url string, options *RequestOpts, state *requestState, resp *http.Response, err error) (time.Duration, bool) {
+	p := client.RetryPolicy
+	attempt := state.retries + 1
+	if !p.canRetry(attempt, method, options) || ctx.Err() != nil {
+		return 0, false
+	}
+	if !p.shouldRetry(method, resp, err) {
+		return 0, false
+	}
+
+	delay := p.backoff(attempt, resp)
+	if p.OnRetry != nil {
+		e := RetryEvent{
+			Method:  method,
+			URL:     url,
+			Attempt: attempt,
+			Err:     err,
+			Delay:   delay,
+		}
+		if resp != nil {
+			e.StatusCode = resp.StatusCode
+		}
+		p.OnRetry(e)
+	}
+	return delay, true
+}
+
+// prepareRetry waits for the given delay and rewinds the request body so that
+// the request can be issued again. It returns the context's error if the
+// context is done before the delay elapsed.
+func (client *ProviderClient) prepareRetry(ctx context.Context, delay time.Duration, options *RequestOpts, state *requestState) error {
+	if err := client.RetryPolicy.wait(ctx, delay); err != nil {
+		return err
+	}
+	if seeker, ok := options.RawBody.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+	state.retries++
+	return nil
+}
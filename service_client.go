@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -29,9 +30,46 @@ type ServiceClient struct {
 	// The microversion of the service to use. Set this to use a particular microversion.
 	Microversion string
 
+	// MinMicroversion and MaxMicroversion are the oldest and newest
+	// microversions supported by the service, if known. They are recorded by
+	// utils.NegotiateMicroversion and used by RequireMicroversion and
+	// HighestMicroversion.
+	MinMicroversion string
+	MaxMicroversion string
+
 	// MoreHeaders allows users (or Gophercloud) to set service-wide headers on requests. Put another way,
 	// values set in this field will be set on all the HTTP requests the service client sends.
 	MoreHeaders map[string]string
+
+	// Middleware is the chain of middleware requests made through this service client pass
+	// through, inside the middleware of the ProviderClient. See Middleware and Use.
+	Middleware []Middleware
+
+	// ctx is the context attached to every request issued through this service client.
+	// It is set by WithContext and takes precedence over the ProviderClient's Context.
+	ctx context.Context
+}
+
+// WithContext returns a shallow copy of the service client whose requests are
+// bound to ctx. The original client is left untouched, so it is safe to derive
+// per-call clients from a ServiceClient shared between goroutines:
+//
+//	server, err := servers.Get(client.WithContext(ctx), id).Extract()
+//
+// Cancelling ctx aborts any in-flight HTTP request made through the returned client.
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+	c := *client
+	c.ctx = ctx
+	return &c
+}
+
+// RequestContext returns the context bound to the service client by WithContext,
+// or nil if none was set.
+func (client *ServiceClient) RequestContext() context.Context {
+	return client.ctx
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -140,17 +178,33 @@ func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
 	}
 }
 
-// Request carries out the HTTP operation for the service client
-func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+// Request carries out the HTTP operation for the service client. opts is
+// not modified, so that it can be reused with other clients.
+func (client *ServiceClient) Request(method, url string, opts *RequestOpts) (*http.Response, error) {
+	options := new(RequestOpts)
+	if opts != nil {
+		*options = *opts
+	}
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		headers := make(map[string]string, len(options.MoreHeaders)+len(client.MoreHeaders))
+		for k, v := range options.MoreHeaders {
+			headers[k] = v
 		}
 		for k, v := range client.MoreHeaders {
-			options.MoreHeaders[k] = v
+			headers[k] = v
 		}
+		options.MoreHeaders = headers
+	}
+	if options.Context == nil && client.ctx != nil {
+		options.Context = client.ctx
 	}
-	return client.ProviderClient.Request(method, url, options)
+	return client.ProviderClient.dispatch(&RequestInfo{
+		Method:       method,
+		URL:          url,
+		Options:      options,
+		ServiceType:  client.Type,
+		Microversion: client.Microversion,
+	}, client.Middleware)
 }
 
 // ParseResponse is a helper function to parse http.Response to constituents.
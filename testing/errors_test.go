@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/yogeshwargnanasekaran/gophercloud"
@@ -23,3 +24,22 @@ func TestGetResponseCode(t *testing.T) {
 	th.AssertEquals(t, true, ok)
 	th.AssertEquals(t, err.GetStatusCode(), 404)
 }
+
+func TestGetResponseHeader(t *testing.T) {
+	respErr := gophercloud.ErrUnexpectedResponseCode{
+		URL:      "http://example.com",
+		Method:   "GET",
+		Expected: []int{200},
+		Actual:   404,
+		ResponseHeader: http.Header{
+			"X-Compute-Request-Id": []string{"req-1234"},
+		},
+	}
+
+	var err404 error = gophercloud.ErrDefault404{ErrUnexpectedResponseCode: respErr}
+
+	err, ok := err404.(gophercloud.ResponseHeaderError)
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "req-1234", err.RequestID())
+	th.AssertEquals(t, "req-1234", err.GetResponseHeader().Get("X-Compute-Request-Id"))
+}
@@ -0,0 +1,151 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestLoggingMiddleware(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Openstack-Request-Id", "req-1234")
+		w.Header().Set("X-Subject-Token", "secret-token")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"server": {"id": "abc", "adminPass": "s3cr3t"}}`)
+	})
+
+	var records []gophercloud.LogRecord
+	p := new(gophercloud.ProviderClient)
+	p.Use(gophercloud.NewLoggingMiddleware(gophercloud.LoggerFunc(func(r gophercloud.LogRecord) {
+		records = append(records, r)
+	}), gophercloud.LoggingOpts{
+		LogBodies:     true,
+		RedactHeaders: []string{"X-Custom-Secret"},
+		RedactBodies: []gophercloud.BodyRedaction{
+			{ServiceType: "compute", Path: []string{"server", "metadata", "*"}},
+		},
+	}))
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: p,
+		Type:           "compute",
+	}
+
+	reqBody := map[string]interface{}{
+		"server": map[string]interface{}{
+			"name":      "test",
+			"adminPass": "s3cr3t",
+			"metadata": map[string]interface{}{
+				"foo": "bar",
+			},
+		},
+	}
+	var respBody interface{}
+	_, err := c.Post(th.Endpoint()+"servers", reqBody, &respBody, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"X-Custom-Secret": "hidden",
+			"X-Visible":       "shown",
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(records))
+	r := records[0]
+	th.AssertEquals(t, "POST", r.Method)
+	th.AssertEquals(t, "compute", r.ServiceType)
+	th.AssertEquals(t, http.StatusAccepted, r.StatusCode)
+	th.AssertEquals(t, "req-1234", r.RequestID)
+	th.AssertEquals(t, "***", r.RequestHeaders.Get("X-Custom-Secret"))
+	th.AssertEquals(t, "shown", r.RequestHeaders.Get("X-Visible"))
+	th.AssertEquals(t, "***", r.ResponseHeaders.Get("X-Subject-Token"))
+	th.AssertEquals(t, `{"server":{"adminPass":"***","metadata":{"foo":"***"},"name":"test"}}`, r.RequestBody)
+	th.AssertEquals(t, `{"server":{"adminPass":"***","id":"abc"}}`, r.ResponseBody)
+
+	// The caller's values are left untouched.
+	th.AssertEquals(t, "s3cr3t", reqBody["server"].(map[string]interface{})["adminPass"])
+	th.AssertEquals(t, "s3cr3t", respBody.(map[string]interface{})["server"].(map[string]interface{})["adminPass"])
+}
+
+func TestLoggingMiddlewareError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Compute-Request-Id", "req-5678")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"itemNotFound": {"message": "gone"}}`)
+	})
+
+	var records []gophercloud.LogRecord
+	p := new(gophercloud.ProviderClient)
+	p.Use(gophercloud.NewLoggingMiddleware(gophercloud.LoggerFunc(func(r gophercloud.LogRecord) {
+		records = append(records, r)
+	}), gophercloud.LoggingOpts{LogBodies: true}))
+
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %T", err)
+	}
+
+	th.AssertEquals(t, 1, len(records))
+	th.AssertEquals(t, http.StatusNotFound, records[0].StatusCode)
+	th.AssertEquals(t, "req-5678", records[0].RequestID)
+	th.AssertEquals(t, `{"itemNotFound":{"message":"gone"}}`, records[0].ResponseBody)
+}
+
+func TestLoggingMiddlewareCatalog(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"token": {"catalog": [{"type": "compute"}]}}`)
+	})
+
+	for _, logCatalogs := range []bool{false, true} {
+		var records []gophercloud.LogRecord
+		p := new(gophercloud.ProviderClient)
+		p.Use(gophercloud.NewLoggingMiddleware(gophercloud.LoggerFunc(func(r gophercloud.LogRecord) {
+			records = append(records, r)
+		}), gophercloud.LoggingOpts{LogBodies: true, LogCatalogs: logCatalogs}))
+
+		var respBody interface{}
+		_, err := p.Request("POST", th.Endpoint()+"auth/tokens", &gophercloud.RequestOpts{
+			JSONBody:     map[string]interface{}{},
+			JSONResponse: &respBody,
+			OkCodes:      []int{201},
+		})
+		th.AssertNoErr(t, err)
+
+		th.AssertEquals(t, 1, len(records))
+		if logCatalogs {
+			th.AssertEquals(t, `{"token":{"catalog":[{"type":"compute"}]}}`, records[0].ResponseBody)
+		} else {
+			th.AssertEquals(t, "", records[0].ResponseBody)
+		}
+	}
+}
+
+func TestRedactHelpers(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Auth-Token", "secret")
+	h.Set("X-Custom-Secret", "hidden")
+	h.Set("X-Visible", "shown")
+
+	redacted := gophercloud.RedactHeaders(h, "x-custom-secret")
+	th.AssertEquals(t, "***", redacted.Get("X-Auth-Token"))
+	th.AssertEquals(t, "***", redacted.Get("X-Custom-Secret"))
+	th.AssertEquals(t, "shown", redacted.Get("X-Visible"))
+	th.AssertEquals(t, "secret", h.Get("X-Auth-Token"))
+
+	body := gophercloud.RedactJSONBody([]byte(`{"auth": {"identity": {"password": {"user": {"name": "me", "password": "s3cr3t"}}}}}`), "")
+	th.AssertEquals(t, `{"auth":{"identity":{"password":{"user":{"name":"me","password":"***"}}}}}`, body)
+
+	th.AssertEquals(t, "", gophercloud.RedactJSONBody([]byte(`{"access": {"serviceCatalog": []}}`), ""))
+	th.AssertEquals(t, "not json", gophercloud.RedactJSONBody([]byte("not json"), ""))
+}
@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestCompareMicroversions(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"2.1", "2.1", 0},
+		{"2.9", "2.10", -1},
+		{"2.60", "2.9", 1},
+		{"3.0", "2.87", 1},
+	}
+	for _, c := range cases {
+		actual, err := gophercloud.CompareMicroversions(c.a, c.b)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, c.expected, actual)
+	}
+
+	_, err := gophercloud.CompareMicroversions("latest", "2.1")
+	if err == nil {
+		t.Fatal("expected an error for an invalid microversion")
+	}
+}
+
+func TestRequireMicroversion(t *testing.T) {
+	c := &gophercloud.ServiceClient{Type: "compute"}
+
+	// Nothing is known about the service yet.
+	th.AssertNoErr(t, c.RequireMicroversion("2.90"))
+
+	c.MinMicroversion = "2.1"
+	c.MaxMicroversion = "2.87"
+	th.AssertNoErr(t, c.RequireMicroversion("2.87"))
+
+	err := c.RequireMicroversion("2.90")
+	th.AssertDeepEquals(t, gophercloud.ErrMicroversionNotSupported{
+		ServiceType: "compute",
+		Required:    "2.90",
+		Min:         "2.1",
+		Max:         "2.87",
+	}, err)
+	th.AssertEquals(t, "Microversion 2.90 is required, but the compute service only supports microversions 2.1 to 2.87", err.Error())
+}
+
+func TestRequireMicroversionCurrent(t *testing.T) {
+	c := &gophercloud.ServiceClient{Type: "compute", Microversion: "2.79"}
+
+	// The requests go out at Microversion, even if the service supports
+	// newer ones.
+	th.AssertNoErr(t, c.RequireMicroversion("2.79"))
+	err := c.RequireMicroversion("2.80")
+	th.AssertEquals(t, "Microversion 2.80 is required, but the compute client uses microversion 2.79", err.Error())
+
+	c.MinMicroversion = "2.1"
+	c.MaxMicroversion = "2.95"
+	err = c.RequireMicroversion("2.90")
+	th.AssertDeepEquals(t, gophercloud.ErrMicroversionNotSupported{
+		ServiceType: "compute",
+		Required:    "2.90",
+		Min:         "2.1",
+		Max:         "2.95",
+		Current:     "2.79",
+	}, err)
+
+	c.Microversion = "latest"
+	th.AssertNoErr(t, c.RequireMicroversion("2.90"))
+}
+
+func TestHighestMicroversion(t *testing.T) {
+	c := &gophercloud.ServiceClient{Type: "volume"}
+
+	v, err := c.HighestMicroversion("3.50")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.50", v)
+
+	c.MinMicroversion = "3.0"
+	c.MaxMicroversion = "3.44"
+
+	v, err = c.HighestMicroversion("3.50")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.44", v)
+
+	v, err = c.HighestMicroversion("3.27")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.27", v)
+
+	_, err = c.HighestMicroversion("2.0")
+	if _, ok := err.(gophercloud.ErrMicroversionNotSupported); !ok {
+		t.Fatalf("expected ErrMicroversionNotSupported, got %T", err)
+	}
+}
+
+func TestHighestMicroversionCurrent(t *testing.T) {
+	c := &gophercloud.ServiceClient{Type: "volume", Microversion: "3.27"}
+
+	v, err := c.HighestMicroversion("3.50")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.27", v)
+
+	c.MinMicroversion = "3.0"
+	c.MaxMicroversion = "3.44"
+
+	v, err = c.HighestMicroversion("3.50")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.27", v)
+
+	v, err = c.HighestMicroversion("3.10")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3.10", v)
+}
@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestMiddlewareOrder(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Injected", "yes")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ok": true}`)
+	})
+
+	var calls []string
+	record := func(name string) gophercloud.Middleware {
+		return func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+			return func(info *gophercloud.RequestInfo) (*http.Response, error) {
+				calls = append(calls, name+":"+info.ServiceType+":"+info.Microversion)
+				resp, err := next(info)
+				calls = append(calls, name+":done")
+				return resp, err
+			}
+		}
+	}
+	inject := func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(info *gophercloud.RequestInfo) (*http.Response, error) {
+			info.Options.MoreHeaders["X-Injected"] = "yes"
+			return next(info)
+		}
+	}
+
+	p := new(gophercloud.ProviderClient)
+	p.Use(record("provider"))
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: p,
+		Type:           "compute",
+		Microversion:   "2.1",
+	}
+	c.Use(record("service"), inject)
+
+	var body map[string]interface{}
+	_, err := c.Get(th.Endpoint()+"route", &body, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, body["ok"])
+
+	expected := []string{
+		"provider:compute:2.1",
+		"service:compute:2.1",
+		"service:done",
+		"provider:done",
+	}
+	th.AssertDeepEquals(t, expected, calls)
+}
+
+func TestMiddlewareSeesMappedError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var seen error
+	p := new(gophercloud.ProviderClient)
+	p.Use(func(next gophercloud.RequestHandler) gophercloud.RequestHandler {
+		return func(info *gophercloud.RequestInfo) (*http.Response, error) {
+			resp, err := next(info)
+			seen = err
+			return resp, err
+		}
+	})
+
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %T", err)
+	}
+	th.AssertDeepEquals(t, err, seen)
+}
@@ -393,6 +393,50 @@ func TestRequestWithContext(t *testing.T) {
 	}
 }
 
+func TestRequestWithOptsContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{Context: context.Background()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: ctx})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	if !strings.Contains(err.Error(), ctx.Err().Error()) {
+		t.Fatalf("expecting error to contain: %q, got %q", ctx.Err().Error(), err.Error())
+	}
+
+	// The client-wide context is still used when no per-request context is given.
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+}
+
+func TestRequestGlobalRequestID(t *testing.T) {
+	var received []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get("X-OpenStack-Request-ID"))
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{GlobalRequestID: "req-1234"}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	// MoreHeaders takes precedence over the client-wide value.
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"X-OpenStack-Request-ID": "req-5678"},
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{"req-1234", "req-5678"}, received)
+}
+
 func TestRequestConnectionReuse(t *testing.T) {
 	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintln(w, "OK")
@@ -2,6 +2,7 @@ package testing
 
 import (
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/yogeshwargnanasekaran/gophercloud"
@@ -206,3 +207,18 @@ func TestUnmarshalSliceOfNamedStructs(t *testing.T) {
 	th.AssertEquals(t, "", actual[1].TestPerson.Name)
 	th.AssertEquals(t, "", actual[1].TestPersonExt.Location)
 }
+
+func TestResultRequestID(t *testing.T) {
+	r := gophercloud.Result{
+		Header: http.Header{
+			"X-Openstack-Request-Id": []string{"req-1234"},
+			"X-Compute-Request-Id":   []string{"req-5678"},
+		},
+	}
+	th.AssertEquals(t, "req-1234", r.RequestID())
+
+	r.Header.Del("X-Openstack-Request-Id")
+	th.AssertEquals(t, "req-5678", r.RequestID())
+
+	th.AssertEquals(t, "", gophercloud.Result{}.RequestID())
+}
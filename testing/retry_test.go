@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func TestRetryOn429(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	var events []gophercloud.RetryEvent
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts: 3,
+			OnRetry: func(e gophercloud.RetryEvent) {
+				events = append(events, e)
+			},
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, calls)
+	th.AssertEquals(t, 2, len(events))
+	th.AssertEquals(t, 1, events[0].Attempt)
+	th.AssertEquals(t, 2, events[1].Attempt)
+	th.AssertEquals(t, 429, events[1].StatusCode)
+	th.AssertEquals(t, time.Duration(0), events[1].Delay)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("expected ErrDefault503, got %T", err)
+	}
+	th.AssertEquals(t, 2, calls)
+}
+
+func TestRetrySkipsNonIdempotent(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(429)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("POST", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("expected ErrDefault429, got %T", err)
+	}
+	th.AssertEquals(t, 1, calls)
+
+	calls = 0
+	p.RetryPolicy.RetryNonIdempotent = true
+	_, err = p.Request("POST", ts.URL, &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("expected ErrDefault429, got %T", err)
+	}
+	th.AssertEquals(t, 3, calls)
+}
+
+func TestRetryRewindsRawBody(t *testing.T) {
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+		},
+	}
+
+	_, err := p.Request("PUT", ts.URL, &gophercloud.RequestOpts{
+		RawBody: strings.NewReader("payload"),
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"payload", "payload"}, bodies)
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(429)
+	}))
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: &gophercloud.RetryPolicy{
+			MaxAttempts: 5,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{Context: ctx})
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+	if time.Since(start) > 10*time.Second {
+		t.Fatal("the retry did not stop when the context expired")
+	}
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -32,3 +33,60 @@ func TestMoreHeaders(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, resp.Request.Header.Get("custom"), "header")
 }
+
+func TestServiceClientWithContext(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := new(gophercloud.ServiceClient)
+	c.ProviderClient = new(gophercloud.ProviderClient)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := c.WithContext(ctx)
+	th.AssertEquals(t, ctx, cc.RequestContext())
+	th.AssertEquals(t, nil, c.RequestContext())
+
+	_, err := cc.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	th.AssertNoErr(t, err)
+
+	cancel()
+	_, err = cc.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+
+	// The original client is not bound to the cancelled context.
+	_, err = c.Get(fmt.Sprintf("%s/route", th.Endpoint()), nil, nil)
+	th.AssertNoErr(t, err)
+}
+
+func TestServiceClientRequestOptsReuse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := new(gophercloud.ServiceClient)
+	c.ProviderClient = new(gophercloud.ProviderClient)
+	c.MoreHeaders = map[string]string{"custom": "header"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The options are not bound to the context or the headers of the first
+	// client they are used with.
+	opts := &gophercloud.RequestOpts{}
+	_, err := c.WithContext(ctx).Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), opts)
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	th.AssertEquals(t, nil, opts.Context)
+	th.AssertEquals(t, 0, len(opts.MoreHeaders))
+
+	_, err = c.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), opts)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,227 @@
+package testing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+func testTokenCache(t *testing.T, cache gophercloud.TokenCache) {
+	token, err := cache.Get("key")
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("expected no token, got %v", token)
+	}
+
+	expected := &gophercloud.CachedToken{
+		ID:              "token",
+		ExpiresAt:       time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+		IdentityVersion: "v3",
+		Body:            json.RawMessage(`{"token":{}}`),
+	}
+	th.AssertNoErr(t, cache.Set("key", expected))
+
+	token, err = cache.Get("key")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, expected, token)
+
+	th.AssertNoErr(t, cache.Delete("key"))
+	th.AssertNoErr(t, cache.Delete("key"))
+
+	token, err = cache.Get("key")
+	th.AssertNoErr(t, err)
+	if token != nil {
+		t.Fatalf("expected no token, got %v", token)
+	}
+}
+
+func TestMemoryTokenCache(t *testing.T) {
+	testTokenCache(t, gophercloud.NewMemoryTokenCache())
+}
+
+func TestFileTokenCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gophercloud-tokens")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	testTokenCache(t, gophercloud.NewFileTokenCache(dir+"/cache"))
+}
+
+func TestTokenCacheKey(t *testing.T) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint: "https://keystone.example.com/v3",
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "default",
+		Scope:            &gophercloud.AuthScope{ProjectName: "project", DomainName: "default"},
+	}
+	key := gophercloud.TokenCacheKey(opts)
+
+	// The endpoint is normalized.
+	same := opts
+	same.IdentityEndpoint = "https://keystone.example.com/v3/"
+	th.AssertEquals(t, key, gophercloud.TokenCacheKey(same))
+
+	otherScope := opts
+	otherScope.Scope = &gophercloud.AuthScope{ProjectName: "other", DomainName: "default"}
+	if gophercloud.TokenCacheKey(otherScope) == key {
+		t.Fatal("expected different keys for different scopes")
+	}
+
+	otherUser := opts
+	otherUser.Username = "other"
+	if gophercloud.TokenCacheKey(otherUser) == key {
+		t.Fatal("expected different keys for different users")
+	}
+
+	// Secrets are not part of the key.
+	otherPassword := opts
+	otherPassword.Password = "other"
+	th.AssertEquals(t, key, gophercloud.TokenCacheKey(otherPassword))
+}
+
+func TestTokenCacheKeyApplicationCredential(t *testing.T) {
+	opts := gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://keystone.example.com/v3",
+		ApplicationCredentialID:     "app-cred-id",
+		ApplicationCredentialSecret: "secret",
+	}
+	key := gophercloud.TokenCacheKey(opts)
+
+	otherSecret := opts
+	otherSecret.ApplicationCredentialSecret = "other"
+	th.AssertEquals(t, key, gophercloud.TokenCacheKey(otherSecret))
+
+	otherCredential := opts
+	otherCredential.ApplicationCredentialID = "other-id"
+	if gophercloud.TokenCacheKey(otherCredential) == key {
+		t.Fatal("expected different keys for different application credentials")
+	}
+}
+
+func TestTokenCacheSecretHash(t *testing.T) {
+	opts := gophercloud.AuthOptions{
+		Username: "me",
+		Password: "secret",
+	}
+	hash, err := gophercloud.HashTokenCacheSecret(opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, gophercloud.VerifyTokenCacheSecret(opts, hash))
+
+	// The hash is salted.
+	other, err := gophercloud.HashTokenCacheSecret(opts)
+	th.AssertNoErr(t, err)
+	if other == hash {
+		t.Fatal("expected different hashes for the same secret")
+	}
+
+	wrongPassword := opts
+	wrongPassword.Password = "wrong"
+	th.AssertEquals(t, false, gophercloud.VerifyTokenCacheSecret(wrongPassword, hash))
+
+	wrongSecret := opts
+	wrongSecret.ApplicationCredentialSecret = "wrong"
+	th.AssertEquals(t, false, gophercloud.VerifyTokenCacheSecret(wrongSecret, hash))
+
+	th.AssertEquals(t, false, gophercloud.VerifyTokenCacheSecret(opts, ""))
+}
+
+type expiringAuthResult struct {
+	id        string
+	expiresAt time.Time
+}
+
+func (r expiringAuthResult) ExtractTokenID() (string, error) {
+	return r.id, nil
+}
+
+func (r expiringAuthResult) ExtractExpiresAt() (time.Time, error) {
+	return r.expiresAt, nil
+}
+
+func TestScheduleTokenRefresh(t *testing.T) {
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	p.TokenRefreshBefore = time.Hour
+	defer p.StopTokenRefresh()
+
+	var reauths int32
+	refreshed := make(chan struct{}, 10)
+	p.ReauthFunc = func() error {
+		n := atomic.AddInt32(&reauths, 1)
+		// The second token lives long enough for no further refresh to happen.
+		p.SetTokenAndAuthResult(expiringAuthResult{
+			id:        "token-2",
+			expiresAt: time.Now().Add(time.Duration(n) * 2 * time.Hour),
+		})
+		refreshed <- struct{}{}
+		return nil
+	}
+
+	// The token expires within TokenRefreshBefore, so it is refreshed right
+	// away.
+	th.AssertNoErr(t, p.SetTokenAndAuthResult(expiringAuthResult{
+		id:        "token-1",
+		expiresAt: time.Now().Add(30 * time.Minute),
+	}))
+	p.ScheduleTokenRefresh()
+
+	select {
+	case <-refreshed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the token was not refreshed")
+	}
+	th.AssertEquals(t, "token-2", p.Token())
+
+	time.Sleep(100 * time.Millisecond)
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(&reauths))
+}
+
+func TestScheduleTokenRefreshWithoutTokenLock(t *testing.T) {
+	p := new(gophercloud.ProviderClient)
+	p.TokenRefreshBefore = time.Hour
+	defer p.StopTokenRefresh()
+
+	refreshed := make(chan struct{}, 10)
+	p.ReauthFunc = func() error {
+		p.SetTokenAndAuthResult(expiringAuthResult{
+			id:        "token-2",
+			expiresAt: time.Now().Add(2 * time.Hour),
+		})
+		refreshed <- struct{}{}
+		return nil
+	}
+
+	th.AssertNoErr(t, p.SetTokenAndAuthResult(expiringAuthResult{
+		id:        "token-1",
+		expiresAt: time.Now().Add(30 * time.Minute),
+	}))
+
+	// Concurrent schedules arm a single timer, and the client is switched to
+	// the token lock so that it can be read while the token is refreshed.
+	var wg sync.WaitGroup
+	p.ScheduleTokenRefresh()
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.ScheduleTokenRefresh()
+			_ = p.Token()
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-refreshed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the token was not refreshed")
+	}
+	th.AssertEquals(t, "token-2", p.Token())
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -53,6 +54,30 @@ func TestWaitForPredicateExceed(t *testing.T) {
 	th.AssertEquals(t, "A timeout occurred", err.Error())
 }
 
+func TestWaitForContext(t *testing.T) {
+	err := gophercloud.WaitForContext(context.Background(), func(ctx context.Context) (bool, error) {
+		return true, nil
+	})
+	th.CheckNoErr(t, err)
+}
+
+func TestWaitForContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+
+	err := gophercloud.WaitForContext(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
+
+func TestWaitForContextError(t *testing.T) {
+	err := gophercloud.WaitForContext(context.Background(), func(ctx context.Context) (bool, error) {
+		return false, errors.New("Error has occurred")
+	})
+	th.AssertEquals(t, "Error has occurred", err.Error())
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
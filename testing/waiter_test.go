@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yogeshwargnanasekaran/gophercloud"
+	th "github.com/yogeshwargnanasekaran/gophercloud/testhelper"
+)
+
+type waitedResource struct {
+	Status string
+	Fault  string
+}
+
+// newWaiter returns a StateWaiter whose resource goes through states, and
+// then stays in the last one.
+func newWaiter(states ...string) (*gophercloud.StateWaiter[*waitedResource], *int) {
+	polls := new(int)
+	return &gophercloud.StateWaiter[*waitedResource]{
+		Get: func(ctx context.Context) (*waitedResource, error) {
+			i := *polls
+			*polls++
+			if i >= len(states) {
+				i = len(states) - 1
+			}
+			if states[i] == "" {
+				return nil, gophercloud.ErrDefault404{}
+			}
+			return &waitedResource{Status: states[i], Fault: "No valid host was found"}, nil
+		},
+		State:    func(r *waitedResource) string { return r.Status },
+		Detail:   func(r *waitedResource) string { return r.Fault },
+		Target:   []string{"ACTIVE"},
+		Failure:  []string{"ERROR"},
+		Interval: time.Millisecond,
+	}, polls
+}
+
+func TestStateWaiter(t *testing.T) {
+	w, polls := newWaiter("BUILD", "BUILD", "ACTIVE")
+	r, err := w.Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ACTIVE", r.Status)
+	th.AssertEquals(t, 3, *polls)
+}
+
+func TestStateWaiterFailure(t *testing.T) {
+	w, polls := newWaiter("BUILD", "ERROR", "ACTIVE")
+	_, err := w.Wait(context.Background())
+
+	failure, ok := err.(gophercloud.ErrStateFailure[*waitedResource])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "ERROR", failure.State)
+	th.AssertEquals(t, false, failure.Unexpected)
+	th.AssertEquals(t, "No valid host was found", failure.Resource.Fault)
+	th.AssertEquals(t, `Resource reached failure state "ERROR" while waiting for [ACTIVE]: No valid host was found`, err.Error())
+	th.AssertEquals(t, 2, *polls)
+}
+
+func TestStateWaiterUnexpected(t *testing.T) {
+	w, _ := newWaiter("BUILD", "SHUTOFF")
+	w.Pending = []string{"BUILD"}
+	_, err := w.Wait(context.Background())
+
+	failure, ok := err.(gophercloud.ErrStateFailure[*waitedResource])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "SHUTOFF", failure.State)
+	th.AssertEquals(t, true, failure.Unexpected)
+}
+
+func TestStateWaiterNotFound(t *testing.T) {
+	w, _ := newWaiter("DELETING", "")
+	_, err := w.Wait(context.Background())
+	_, ok := err.(gophercloud.ErrDefault404)
+	th.AssertEquals(t, true, ok)
+
+	w, polls := newWaiter("DELETING", "")
+	w.NotFoundIsTarget = true
+	r, err := w.Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, r == nil)
+	th.AssertEquals(t, 2, *polls)
+}
+
+func TestStateWaiterTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	w, _ := newWaiter("BUILD")
+	_, err := w.Wait(ctx)
+
+	timeout, ok := err.(gophercloud.ErrStateWaitTimeout[*waitedResource])
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, "BUILD", timeout.State)
+	th.AssertEquals(t, "BUILD", timeout.Resource.Status)
+	th.AssertEquals(t, true, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestStateWaiterBackoff(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	w, polls := newWaiter("BUILD")
+	w.Interval = 10 * time.Millisecond
+	w.Multiplier = 2
+	w.MaxInterval = 40 * time.Millisecond
+	_, err := w.Wait(ctx)
+	th.AssertEquals(t, true, errors.Is(err, context.DeadlineExceeded))
+
+	// Polls happen at 0, 10, 30 and 70ms, against 10 polls without backoff.
+	th.AssertEquals(t, true, *polls >= 2 && *polls <= 4)
+}
@@ -0,0 +1,256 @@
+package gophercloud
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// tokenCacheSecretIterations is the number of PBKDF2 iterations of the
+	// secret hashes of the cached tokens.
+	tokenCacheSecretIterations = 100000
+
+	// tokenCacheSecretScheme identifies the algorithm of the secret hashes.
+	tokenCacheSecretScheme = "pbkdf2-sha256"
+)
+
+// CachedToken is a Keystone token stored in a TokenCache, together with the
+// response it was issued in, so that its service catalog can be reused.
+type CachedToken struct {
+	// ID is the token ID.
+	ID string `json:"id"`
+
+	// ExpiresAt is the time at which the token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// IdentityVersion is the version of the identity API that issued the
+	// token, either "v2.0" or "v3".
+	IdentityVersion string `json:"identity_version"`
+
+	// Body is the JSON body of the response to the token request.
+	Body json.RawMessage `json:"body"`
+
+	// SecretHash is a salted hash of the secrets the token was obtained
+	// with, see HashTokenCacheSecret. The token is only reused by callers
+	// holding the same secrets.
+	SecretHash string `json:"secret_hash,omitempty"`
+}
+
+// TokenCache stores tokens so that they can be reused by several
+// ProviderClients, possibly across processes. openstack.Authenticate consults
+// the TokenCache of the ProviderClient, if any, before requesting a new token.
+//
+// Implementations must be safe for concurrent use.
+type TokenCache interface {
+	// Get returns the token stored under key, or nil if there is none.
+	Get(key string) (*CachedToken, error)
+
+	// Set stores token under key, replacing any previous token.
+	Set(key string, token *CachedToken) error
+
+	// Delete removes the token stored under key, if any.
+	Delete(key string) error
+}
+
+// TokenCacheKey returns the key under which a token obtained with opts is
+// stored in a TokenCache. The key covers the identity endpoint, the user or
+// application credential, the domain and the scope of opts. Secrets such as
+// the password are left out, so that the key, which FileTokenCache uses as a
+// file name, cannot be used to guess them; they are checked against the
+// SecretHash of the cached token instead.
+func TokenCacheKey(opts AuthOptions) string {
+	var scope AuthScope
+	if opts.Scope != nil {
+		scope = *opts.Scope
+	}
+
+	h := sha256.New()
+	_ = json.NewEncoder(h).Encode([]interface{}{
+		NormalizeURL(opts.IdentityEndpoint),
+		opts.UserID, opts.Username, opts.DomainID, opts.DomainName,
+		opts.ApplicationCredentialID, opts.ApplicationCredentialName,
+		opts.TenantID, opts.TenantName,
+		scope.ProjectID, scope.ProjectName, scope.DomainID, scope.DomainName, scope.System,
+	})
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tokenCacheSecret returns the secrets of opts that TokenCacheKey leaves out.
+func tokenCacheSecret(opts AuthOptions) []byte {
+	b, _ := json.Marshal([]string{opts.Password, opts.ApplicationCredentialSecret})
+	return b
+}
+
+// HashTokenCacheSecret returns a salted PBKDF2 hash of the password and the
+// application credential secret of opts, to be stored as the SecretHash of
+// the tokens obtained with opts.
+func HashTokenCacheSecret(opts AuthOptions) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	hash := pbkdf2.Key(tokenCacheSecret(opts), salt, tokenCacheSecretIterations, sha256.Size, sha256.New)
+
+	enc := base64.RawStdEncoding
+	return strings.Join([]string{
+		tokenCacheSecretScheme,
+		strconv.Itoa(tokenCacheSecretIterations),
+		enc.EncodeToString(salt),
+		enc.EncodeToString(hash),
+	}, "$"), nil
+}
+
+// VerifyTokenCacheSecret reports whether hash, as returned by
+// HashTokenCacheSecret, was computed from the same password and application
+// credential secret as those of opts.
+func VerifyTokenCacheSecret(opts AuthOptions, hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != tokenCacheSecretScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return false
+	}
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	expected, err := enc.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+
+	actual := pbkdf2.Key(tokenCacheSecret(opts), salt, iterations, len(expected), sha256.New)
+	return subtle.ConstantTimeCompare(actual, expected) == 1
+}
+
+// MemoryTokenCache is a TokenCache that keeps tokens in memory. It allows
+// several ProviderClients of the same process to share tokens.
+type MemoryTokenCache struct {
+	mut    sync.RWMutex
+	tokens map[string]CachedToken
+}
+
+// NewMemoryTokenCache returns an empty MemoryTokenCache.
+func NewMemoryTokenCache() *MemoryTokenCache {
+	return &MemoryTokenCache{
+		tokens: make(map[string]CachedToken),
+	}
+}
+
+// Get implements TokenCache.
+func (c *MemoryTokenCache) Get(key string) (*CachedToken, error) {
+	c.mut.RLock()
+	defer c.mut.RUnlock()
+
+	token, ok := c.tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// Set implements TokenCache.
+func (c *MemoryTokenCache) Set(key string, token *CachedToken) error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.tokens[key] = *token
+	return nil
+}
+
+// Delete implements TokenCache.
+func (c *MemoryTokenCache) Delete(key string) error {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	delete(c.tokens, key)
+	return nil
+}
+
+// FileTokenCache is a TokenCache that stores each token in its own file of a
+// directory, readable by the current user only. It allows processes to reuse
+// the tokens obtained by previous runs.
+type FileTokenCache struct {
+	// Dir is the directory holding the token files. It is created on demand.
+	Dir string
+}
+
+// NewFileTokenCache returns a FileTokenCache storing its tokens in dir.
+func NewFileTokenCache(dir string) *FileTokenCache {
+	return &FileTokenCache{Dir: dir}
+}
+
+func (c *FileTokenCache) path(key string) string {
+	return filepath.Join(c.Dir, key+".json")
+}
+
+// Get implements TokenCache. A token file that cannot be parsed is treated as
+// missing.
+func (c *FileTokenCache) Get(key string) (*CachedToken, error) {
+	b, err := ioutil.ReadFile(c.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var token CachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, nil
+	}
+	return &token, nil
+}
+
+// Set implements TokenCache. The token file is replaced atomically, so that
+// concurrent readers never see a partially written token.
+func (c *FileTokenCache) Set(key string, token *CachedToken) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.Dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(c.Dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path(key))
+}
+
+// Delete implements TokenCache.
+func (c *FileTokenCache) Delete(key string) error {
+	err := os.Remove(c.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
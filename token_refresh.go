@@ -0,0 +1,108 @@
+package gophercloud
+
+import (
+	"time"
+)
+
+// tokenRefreshRetryInterval is the delay after which a failed background
+// token refresh is attempted again.
+const tokenRefreshRetryInterval = 30 * time.Second
+
+// expiringAuthResult is implemented by the AuthResults that know when their
+// token expires, such as the results of the identity v2 and v3 token
+// requests.
+type expiringAuthResult interface {
+	ExtractExpiresAt() (time.Time, error)
+}
+
+// ScheduleTokenRefresh arms the background refresh of the token according to
+// TokenRefreshBefore, replacing any refresh scheduled previously. Nothing is
+// scheduled if TokenRefreshBefore is not positive, if the client has no
+// ReauthFunc, or if the expiry of the token is unknown.
+//
+// openstack.Authenticate calls ScheduleTokenRefresh once it has obtained a
+// token, and Reauthenticate calls it after every successful
+// reauthentication. Custom authentication code may call it after
+// SetTokenAndAuthResult.
+//
+// Since the token is then refreshed from another goroutine, the client is
+// switched to UseTokenLock if it does not use it yet. The first call must
+// therefore happen before the client is shared between goroutines.
+//
+// If a background refresh fails, it is attempted again every 30 seconds until
+// the token expires. Refreshing stops once the client's Context is done or
+// StopTokenRefresh is called.
+func (client *ProviderClient) ScheduleTokenRefresh() {
+	if client.IsThrowaway() {
+		return
+	}
+
+	if client.TokenRefreshBefore <= 0 || client.ReauthFunc == nil {
+		client.StopTokenRefresh()
+		return
+	}
+	r, ok := client.GetAuthResult().(expiringAuthResult)
+	if !ok {
+		client.StopTokenRefresh()
+		return
+	}
+	expiresAt, err := r.ExtractExpiresAt()
+	if err != nil || expiresAt.IsZero() {
+		client.StopTokenRefresh()
+		return
+	}
+
+	if client.mut == nil {
+		client.UseTokenLock()
+	}
+	client.scheduleTokenRefresh(expiresAt, time.Until(expiresAt)-client.TokenRefreshBefore)
+}
+
+// StopTokenRefresh cancels the background refresh of the token, if one is
+// scheduled.
+func (client *ProviderClient) StopTokenRefresh() {
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	if client.refreshTimer != nil {
+		client.refreshTimer.Stop()
+		client.refreshTimer = nil
+	}
+}
+
+// scheduleTokenRefresh replaces the refresh timer of the client, which must
+// use the token lock.
+func (client *ProviderClient) scheduleTokenRefresh(expiresAt time.Time, delay time.Duration) {
+	if delay < 0 {
+		delay = 0
+	}
+	token := client.Token()
+
+	client.mut.Lock()
+	defer client.mut.Unlock()
+	if client.refreshTimer != nil {
+		client.refreshTimer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		if client.Context != nil && client.Context.Err() != nil {
+			return
+		}
+		// Passing the current token skips the refresh if the client has
+		// reauthenticated in the meantime, in which case a new refresh has
+		// been scheduled already.
+		if err := client.Reauthenticate(token); err != nil {
+			client.mut.RLock()
+			current := client.refreshTimer == timer
+			client.mut.RUnlock()
+
+			// Do not replace a refresh scheduled or stopped in the meantime.
+			if current && time.Until(expiresAt) > tokenRefreshRetryInterval {
+				client.scheduleTokenRefresh(expiresAt, tokenRefreshRetryInterval)
+			}
+		}
+	})
+	client.refreshTimer = timer
+}
@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -54,6 +55,36 @@ func WaitFor(timeout int, predicate func() (bool, error)) error {
 	}
 }
 
+// WaitForContext polls a predicate function, once per second, until it
+// reports success, returns an error, or ctx is done. The predicate receives
+// ctx so that any requests it performs are aborted as soon as ctx is
+// cancelled. When ctx expires first, ctx.Err() is returned.
+//
+// Use context.WithTimeout to bound the total time spent waiting.
+func WaitForContext(ctx context.Context, predicate func(ctx context.Context) (bool, error)) error {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		satisfied, err := predicate(ctx)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			return err
+		}
+		if satisfied {
+			return nil
+		}
+	}
+}
+
 // NormalizeURL is an internal function to be used by provider clients.
 //
 // It ensures that each endpoint URL has a closing `/`, as expected by
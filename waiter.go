@@ -0,0 +1,226 @@
+package gophercloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StateWaiter polls a resource until it reaches one of the Target states. It
+// fails as soon as the resource reaches one of the Failure states, instead of
+// polling until the context expires.
+//
+// Resource packages provide StateWaiters configured for their resources, for
+// example servers.NewStatusWaiter, whose fields may be adjusted before calling
+// Wait.
+type StateWaiter[T any] struct {
+	// Get fetches the resource. Requests it issues should be bound to ctx, so
+	// that cancelling it aborts an in-flight poll.
+	Get func(ctx context.Context) (T, error)
+
+	// State returns the state of the resource.
+	State func(resource T) string
+
+	// Detail, if set, returns a description of what went wrong with the
+	// resource, such as the fault of a server, to be included in the errors
+	// returned by Wait.
+	Detail func(resource T) string
+
+	// Target are the states Wait waits for.
+	Target []string
+
+	// Pending are the states the resource is expected to go through before
+	// reaching a Target state. If Pending is not empty, reaching a state that
+	// is neither a Target, Pending nor Failure state fails Wait. Otherwise,
+	// any such state is considered pending.
+	Pending []string
+
+	// Failure are the states from which the resource will not reach a Target
+	// state.
+	Failure []string
+
+	// NotFoundIsTarget makes Wait succeed when Get returns a 404 error, which
+	// is useful to wait for the deletion of a resource. Wait then returns the
+	// zero value of T.
+	NotFoundIsTarget bool
+
+	// Interval is the delay between the first two polls. It defaults to one
+	// second.
+	Interval time.Duration
+
+	// Multiplier, if greater than 1, multiplies the delay after every poll,
+	// for an exponential backoff.
+	Multiplier float64
+
+	// MaxInterval, if positive, caps the delay between two polls.
+	MaxInterval time.Duration
+}
+
+// Wait polls the resource until it reaches one of the Target states, and
+// returns it. The first poll happens immediately.
+//
+// If the resource reaches a Failure state, or an unexpected state, an
+// ErrStateFailure is returned. If ctx is done first, an ErrStateWaitTimeout
+// wrapping ctx.Err() is returned. In both cases, the error carries the last
+// observed resource. Errors returned by Get are returned as they are.
+func (w *StateWaiter[T]) Wait(ctx context.Context) (T, error) {
+	var last T
+	var lastState string
+	var polled bool
+
+	interval := w.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	for {
+		resource, err := w.Get(ctx)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return last, w.timeout(ctxErr, last, lastState, polled)
+		}
+		if err != nil {
+			if _, ok := err.(ErrDefault404); ok && w.NotFoundIsTarget {
+				var zero T
+				return zero, nil
+			}
+			return last, err
+		}
+
+		last, lastState, polled = resource, w.State(resource), true
+		switch {
+		case containsState(w.Target, lastState):
+			return resource, nil
+		case containsState(w.Failure, lastState):
+			return resource, w.failure(resource, lastState, false)
+		case len(w.Pending) > 0 && !containsState(w.Pending, lastState):
+			return resource, w.failure(resource, lastState, true)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, w.timeout(ctx.Err(), last, lastState, polled)
+		case <-timer.C:
+		}
+
+		if w.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * w.Multiplier)
+		}
+		if w.MaxInterval > 0 && interval > w.MaxInterval {
+			interval = w.MaxInterval
+		}
+	}
+}
+
+func (w *StateWaiter[T]) failure(resource T, state string, unexpected bool) error {
+	err := ErrStateFailure[T]{
+		State:      state,
+		Target:     w.Target,
+		Unexpected: unexpected,
+		Resource:   resource,
+	}
+	if w.Detail != nil {
+		err.Detail = w.Detail(resource)
+	}
+	return err
+}
+
+func (w *StateWaiter[T]) timeout(ctxErr error, resource T, state string, polled bool) error {
+	err := ErrStateWaitTimeout[T]{
+		State:    state,
+		Target:   w.Target,
+		Polled:   polled,
+		Resource: resource,
+		Err:      ctxErr,
+	}
+	if polled && w.Detail != nil {
+		err.Detail = w.Detail(resource)
+	}
+	return err
+}
+
+func containsState(states []string, state string) bool {
+	for _, s := range states {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrStateFailure is returned by StateWaiter.Wait when the resource reaches
+// one of its Failure states, or a state it does not expect.
+type ErrStateFailure[T any] struct {
+	BaseError
+
+	// State is the state the resource reached.
+	State string
+
+	// Target are the states that were waited for.
+	Target []string
+
+	// Unexpected is true if State is neither a Target, Pending nor Failure
+	// state.
+	Unexpected bool
+
+	// Resource is the resource in State.
+	Resource T
+
+	// Detail describes what went wrong with the resource, if known.
+	Detail string
+}
+
+func (e ErrStateFailure[T]) Error() string {
+	reached := "failure"
+	if e.Unexpected {
+		reached = "unexpected"
+	}
+	e.DefaultErrString = fmt.Sprintf("Resource reached %s state %q while waiting for [%s]",
+		reached, e.State, strings.Join(e.Target, ", "))
+	if e.Detail != "" {
+		e.DefaultErrString += ": " + e.Detail
+	}
+	return e.choseErrString()
+}
+
+// ErrStateWaitTimeout is returned by StateWaiter.Wait when its context is done
+// before the resource reaches one of the Target states.
+type ErrStateWaitTimeout[T any] struct {
+	BaseError
+
+	// State is the last observed state of the resource.
+	State string
+
+	// Target are the states that were waited for.
+	Target []string
+
+	// Polled is false if the resource could not be fetched once, in which
+	// case State and Resource are not set.
+	Polled bool
+
+	// Resource is the last observed resource.
+	Resource T
+
+	// Detail describes what went wrong with the resource, if known.
+	Detail string
+
+	// Err is the error of the context.
+	Err error
+}
+
+func (e ErrStateWaitTimeout[T]) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Timed out waiting for [%s]: %s", strings.Join(e.Target, ", "), e.Err)
+	if e.Polled {
+		e.DefaultErrString += fmt.Sprintf(", last state was %q", e.State)
+	}
+	if e.Detail != "" {
+		e.DefaultErrString += ": " + e.Detail
+	}
+	return e.choseErrString()
+}
+
+func (e ErrStateWaitTimeout[T]) Unwrap() error {
+	return e.Err
+}